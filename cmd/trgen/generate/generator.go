@@ -23,6 +23,11 @@ func GenerateRegistry(config types.Config, templates []types.TemplateInfo) error
 		return fmt.Errorf("failed to generate interface registry: %w", err)
 	}
 
+	// Generate type-safe URL builders for all page routes
+	if err := generateRoutesPackage(config, templates); err != nil {
+		return fmt.Errorf("failed to generate routes package: %w", err)
+	}
+
 	return nil
 }
 
@@ -48,6 +48,42 @@ func TestGenerateRegistry(t *testing.T) {
 			TemplatePath: "app/error-demo/page.templ",
 			HumanName:    "error-demo.Page",
 		},
+		{
+			FunctionName: "Stats",
+			PackageName:  "app",
+			ImportPath:   "github.com/test/project/app",
+			PackageAlias: "app",
+			RoutePattern: "/stats",
+			TemplateKey:  "test-key-4",
+			FilePath:     "/test/app/stats_templ.go",
+			TemplatePath: "app/stats.templ",
+			HumanName:    "Stats",
+			IsComponent:  true,
+		},
+		{
+			FunctionName: "Action",
+			PackageName:  "app",
+			ImportPath:   "github.com/test/project/app",
+			PackageAlias: "app",
+			TemplateKey:  "test-key-5",
+			FilePath:     "/test/app/action.go",
+			TemplatePath: "app/action.go",
+			HumanName:    "Action",
+			IsAction:     true,
+		},
+		{
+			FunctionName: "Handler",
+			PackageName:  "export",
+			ImportPath:   "github.com/test/project/app/export",
+			PackageAlias: "export",
+			RoutePattern: "/export",
+			TemplateKey:  "test-key-6",
+			FilePath:     "/test/app/export/route.go",
+			TemplatePath: "app/export/route.go",
+			HumanName:    "export.Handler",
+			IsHandler:    true,
+			HandlerKind:  "func",
+		},
 	}
 
 	config := types.Config{
@@ -116,6 +152,38 @@ func TestGenerateRegistry(t *testing.T) {
 		}
 	}
 
+	// Only components are exposed as page package fragments
+	componentMapping := `shared.PackageComponentKey("app/stats.templ", "Stats"): shared.GenerateTemplateKey("app/stats.templ#Stats"),`
+	if !strings.Contains(contentStr, componentMapping) {
+		t.Errorf("Registry should contain component mapping: %s", componentMapping)
+	}
+	if strings.Contains(contentStr, `shared.PackageComponentKey("app/page.templ", "Page")`) {
+		t.Error("Registry must not expose pages as components")
+	}
+
+	// The page action is registered by package, never as template or route
+	actionMapping := `shared.PageActionKey("app/action.go"): app.Action,`
+	if !strings.Contains(contentStr, actionMapping) {
+		t.Errorf("Registry should contain action mapping: %s", actionMapping)
+	}
+	if strings.Contains(contentStr, `shared.GenerateTemplateKey("app/action.go#Action")`) {
+		t.Error("Registry must not expose actions as templates")
+	}
+
+	// The route handler is registered for the route of its directory, never as template
+	handlerMappings := []string{
+		`"/export": shared.RouteHandlerKey("app/export/route.go"),`,
+		`shared.RouteHandlerKey("app/export/route.go"): http.HandlerFunc(export.Handler),`,
+	}
+	for _, mapping := range handlerMappings {
+		if !strings.Contains(contentStr, mapping) {
+			t.Errorf("Registry should contain route handler mapping: %s", mapping)
+		}
+	}
+	if strings.Contains(contentStr, `shared.GenerateTemplateKey("app/export/route.go#Handler")`) {
+		t.Error("Registry must not expose route handlers as templates")
+	}
+
 	// Verify no invalid identifiers
 	if strings.Contains(contentStr, "error-demo \"") {
 		t.Error("Registry should not contain invalid Go identifiers like 'error-demo'")
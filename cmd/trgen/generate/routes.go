@@ -0,0 +1,236 @@
+package generate
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+
+	"github.com/denkhaus/templ-router/cmd/trgen/types"
+	"github.com/denkhaus/templ-router/cmd/trgen/version"
+	"github.com/denkhaus/templ-router/pkg/shared"
+)
+
+// routesPackageName is the package (and directory below the output dir) of the URL builders
+const routesPackageName = "routes"
+
+// localeParamName is filled from the request context by the context-aware builders
+const localeParamName = "locale"
+
+// generateRoutesPackage generates one URL builder function per page route
+func generateRoutesPackage(config types.Config, templates []types.TemplateInfo) error {
+	outputDir := filepath.Join(config.OutputDir, routesPackageName)
+	outputPath := filepath.Join(outputDir, "routes.go")
+
+	builders := buildRouteBuilders(templates)
+	if len(builders) == 0 {
+		// Remove stale builders so renamed routes cannot linger
+		if err := os.Remove(outputPath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove existing routes file: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create routes directory: %w", err)
+	}
+
+	buildInfo := version.GetBuildInfo()
+	data := struct {
+		PackageName      string
+		Routes           []types.RouteBuilder
+		NeedsURL         bool
+		NeedsSegments    bool
+		NeedsContext     bool
+		GeneratorVersion string
+		GeneratedAt      string
+	}{
+		PackageName:      routesPackageName,
+		Routes:           builders,
+		GeneratorVersion: buildInfo.Short(),
+		GeneratedAt:      time.Now().Format("2006-01-02 15:04:05 MST"),
+	}
+	for _, builder := range builders {
+		data.NeedsURL = data.NeedsURL || strings.Contains(builder.URLExpr, "url.PathEscape")
+		data.NeedsSegments = data.NeedsSegments || strings.Contains(builder.URLExpr, "Segments(")
+		data.NeedsContext = data.NeedsContext || builder.HasLocale
+	}
+	data.NeedsURL = data.NeedsURL || data.NeedsSegments
+
+	tmpl, err := template.ParseFS(generatorTemplates, "templates/routes.go.tmpl")
+	if err != nil {
+		return fmt.Errorf("failed to parse routes template: %w", err)
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute routes template: %w", err)
+	}
+
+	source, err := format.Source([]byte(buf.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format generated routes: %w", err)
+	}
+
+	if err := os.WriteFile(outputPath, source, 0644); err != nil {
+		return fmt.Errorf("failed to write routes file: %w", err)
+	}
+
+	return nil
+}
+
+// buildRouteBuilders creates builders for all page templates and route handlers, sorted by route pattern
+func buildRouteBuilders(templates []types.TemplateInfo) []types.RouteBuilder {
+	patterns := make(map[string]bool)
+	for _, tmpl := range templates {
+		if (tmpl.FunctionName == "Page" || tmpl.IsHandler) && tmpl.RoutePattern != "" {
+			patterns[tmpl.RoutePattern] = true
+		}
+	}
+
+	sorted := make([]string, 0, len(patterns))
+	for pattern := range patterns {
+		sorted = append(sorted, pattern)
+	}
+	sort.Strings(sorted)
+
+	var builders []types.RouteBuilder
+	usedNames := make(map[string]int)
+	for _, pattern := range sorted {
+		builder := buildRouteBuilder(pattern)
+
+		// Keep function names unique, e.g. "/{id}" and "/id" both map to "Id"
+		if count, exists := usedNames[builder.FunctionName]; exists {
+			usedNames[builder.FunctionName] = count + 1
+			builder.FunctionName = fmt.Sprintf("%s%d", builder.FunctionName, count+1)
+		} else {
+			usedNames[builder.FunctionName] = 1
+		}
+
+		builders = append(builders, builder)
+	}
+
+	return builders
+}
+
+// buildRouteBuilder derives function name, parameters and URL expression from a route pattern
+// e.g., "/{locale}/user/{id}" -> LocaleUserId(locale string, id string)
+func buildRouteBuilder(pattern string) types.RouteBuilder {
+	builder := types.RouteBuilder{RoutePattern: pattern}
+
+	var nameParts, params, ctxParams, exprParts []string
+	literal := ""
+	flush := func() {
+		if literal != "" {
+			exprParts = append(exprParts, strconv.Quote(literal))
+			literal = ""
+		}
+	}
+
+	segments := strings.Split(strings.Trim(pattern, "/"), "/")
+	for i, segment := range segments {
+		if segment == "" {
+			continue
+		}
+
+		if name, optional, ok := shared.ParseCatchAllSegment(segment); ok {
+			arg := goIdentifier(name)
+			nameParts = append(nameParts, exportedName(name))
+			params = append(params, arg+" ...string")
+			ctxParams = append(ctxParams, arg+" ...string")
+			if optional && i > 0 {
+				// The section root is served without a trailing slash
+				flush()
+				exprParts = append(exprParts, "optionalSegments("+arg+")")
+			} else {
+				literal += "/"
+				flush()
+				exprParts = append(exprParts, "joinSegments("+arg+")")
+			}
+			continue
+		}
+
+		if name, ok := shared.ParseRouteParamSegment(segment); ok {
+			arg := goIdentifier(name)
+			nameParts = append(nameParts, exportedName(name))
+			params = append(params, arg+" string")
+			if name == localeParamName {
+				builder.HasLocale = true
+				builder.LocaleArg = arg
+			} else {
+				ctxParams = append(ctxParams, arg+" string")
+			}
+			literal += "/"
+			flush()
+			exprParts = append(exprParts, "url.PathEscape("+arg+")")
+			continue
+		}
+
+		nameParts = append(nameParts, exportedName(segment))
+		literal += "/" + segment
+	}
+
+	if len(exprParts) == 0 && literal == "" {
+		literal = "/"
+	}
+	flush()
+
+	builder.FunctionName = strings.Join(nameParts, "")
+	if builder.FunctionName == "" {
+		builder.FunctionName = "Root"
+	}
+	if !unicode.IsLetter(rune(builder.FunctionName[0])) {
+		builder.FunctionName = "Route" + builder.FunctionName
+	}
+
+	builder.Params = strings.Join(params, ", ")
+	builder.CtxParams = strings.Join(append([]string{"ctx context.Context"}, ctxParams...), ", ")
+	builder.URLExpr = strings.Join(exprParts, " + ")
+
+	return builder
+}
+
+// exportedName converts a route segment into an exported Go identifier part
+// e.g., "user_profile" -> "UserProfile", "orgId" -> "OrgId"
+func exportedName(segment string) string {
+	var result strings.Builder
+	upper := true
+	for _, r := range segment {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
+
+// goIdentifier converts a parameter name into a valid, non-keyword Go identifier
+func goIdentifier(name string) string {
+	var result strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			result.WriteRune(r)
+		}
+	}
+
+	identifier := result.String()
+	if identifier == "" || unicode.IsDigit(rune(identifier[0])) {
+		identifier = "p" + identifier
+	}
+	if token.IsKeyword(identifier) || identifier == "ctx" {
+		identifier += "Param"
+	}
+	return identifier
+}
@@ -0,0 +1,136 @@
+package generate
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/denkhaus/templ-router/cmd/trgen/types"
+)
+
+func TestBuildRouteBuilder(t *testing.T) {
+	tests := []struct {
+		pattern      string
+		functionName string
+		params       string
+		urlExpr      string
+		hasLocale    bool
+		localeArg    string
+	}{
+		{"/", "Root", "", `"/"`, false, ""},
+		{"/about", "About", "", `"/about"`, false, ""},
+		{"/{locale}/user/{id}", "LocaleUserId", "locale string, id string",
+			`"/" + url.PathEscape(locale) + "/user/" + url.PathEscape(id)`, true, "locale"},
+		{"/user_profile/{type}", "UserProfileType", "typeParam string",
+			`"/user_profile/" + url.PathEscape(typeParam)`, false, ""},
+		{"/docs/{slug...}", "DocsSlug", "slug ...string", `"/docs/" + joinSegments(slug)`, false, ""},
+		{"/{locale}/docs/{slug...?}", "LocaleDocsSlug", "locale string, slug ...string",
+			`"/" + url.PathEscape(locale) + "/docs" + optionalSegments(slug)`, true, "locale"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			builder := buildRouteBuilder(tt.pattern)
+
+			if builder.FunctionName != tt.functionName {
+				t.Errorf("FunctionName = %q, want %q", builder.FunctionName, tt.functionName)
+			}
+			if builder.Params != tt.params {
+				t.Errorf("Params = %q, want %q", builder.Params, tt.params)
+			}
+			if builder.URLExpr != tt.urlExpr {
+				t.Errorf("URLExpr = %q, want %q", builder.URLExpr, tt.urlExpr)
+			}
+			if builder.HasLocale != tt.hasLocale {
+				t.Errorf("HasLocale = %v, want %v", builder.HasLocale, tt.hasLocale)
+			}
+			if builder.LocaleArg != tt.localeArg {
+				t.Errorf("LocaleArg = %q, want %q", builder.LocaleArg, tt.localeArg)
+			}
+		})
+	}
+}
+
+func TestBuildRouteBuildersOnlyPagesWithUniqueNames(t *testing.T) {
+	builders := buildRouteBuilders([]types.TemplateInfo{
+		{FunctionName: "Page", RoutePattern: "/id"},
+		{FunctionName: "Page", RoutePattern: "/{id}"},
+		{FunctionName: "Layout", RoutePattern: "/layout"},
+		{FunctionName: "Error", RoutePattern: "/error"},
+	})
+
+	if len(builders) != 2 {
+		t.Fatalf("expected 2 builders, got %d", len(builders))
+	}
+	if builders[0].FunctionName != "Id" || builders[1].FunctionName != "Id2" {
+		t.Errorf("expected unique names Id and Id2, got %s and %s", builders[0].FunctionName, builders[1].FunctionName)
+	}
+}
+
+func TestBuildRouteBuildersIncludeRouteHandlers(t *testing.T) {
+	builders := buildRouteBuilders([]types.TemplateInfo{
+		{FunctionName: "Page", RoutePattern: "/reports"},
+		{FunctionName: "Handler", RoutePattern: "/reports/export", IsHandler: true},
+		{FunctionName: "Action", IsAction: true},
+	})
+
+	if len(builders) != 2 {
+		t.Fatalf("expected 2 builders, got %d", len(builders))
+	}
+	if builders[1].FunctionName != "ReportsExport" {
+		t.Errorf("expected route handler builder ReportsExport, got %s", builders[1].FunctionName)
+	}
+}
+
+func TestGenerateRoutesPackage(t *testing.T) {
+	tempDir := t.TempDir()
+	config := types.Config{
+		ModuleName:  "github.com/test/project",
+		ScanPath:    tempDir,
+		OutputDir:   tempDir,
+		PackageName: "templates",
+	}
+
+	err := GenerateRegistry(config, []types.TemplateInfo{
+		{FunctionName: "Page", RoutePattern: "/", TemplateKey: "root", ImportPath: "github.com/test/project/app", PackageName: "app"},
+		{FunctionName: "Page", RoutePattern: "/{locale}/user/{id}", TemplateKey: "user", ImportPath: "github.com/test/project/app/locale_/user/id_", PackageName: "id_"},
+	})
+	if err != nil {
+		t.Fatalf("GenerateRegistry failed: %v", err)
+	}
+
+	outputFile := filepath.Join(tempDir, "routes", "routes.go")
+	content, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("routes file was not created: %v", err)
+	}
+
+	if _, err := parser.ParseFile(token.NewFileSet(), outputFile, content, parser.AllErrors); err != nil {
+		t.Fatalf("generated routes do not parse: %v", err)
+	}
+
+	contentStr := string(content)
+	for _, expected := range []string{
+		"package routes",
+		"func Root() templ.SafeURL",
+		"func LocaleUserId(locale string, id string) templ.SafeURL",
+		"func LocaleUserIdCtx(ctx context.Context, id string) templ.SafeURL",
+		`shared.JoinBasePath(shared.URLBasePath(), "/")`,
+		`shared.JoinBasePath(shared.GetBasePath(ctx), "/"+url.PathEscape(locale)+"/user/"+url.PathEscape(id))`,
+	} {
+		if !strings.Contains(contentStr, expected) {
+			t.Errorf("expected %q in generated routes", expected)
+		}
+	}
+
+	// Regenerating without pages removes stale builders
+	if err := GenerateRegistry(config, nil); err != nil {
+		t.Fatalf("GenerateRegistry failed: %v", err)
+	}
+	if _, err := os.Stat(outputFile); !os.IsNotExist(err) {
+		t.Error("expected stale routes file to be removed")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/ast"
 	gotypes "go/types"
+	"path/filepath"
 	"strings"
 
 	"github.com/denkhaus/templ-router/cmd/trgen/types"
@@ -142,8 +143,25 @@ func ScanTemplatesWithPackages(config types.Config) ([]types.TemplateInfo, []str
 			// Get the file path
 			filePath := pkg.Fset.Position(file.Pos()).Filename
 
-			// Only process _templ.go files
+			// Plain Go files may declare the Action of a page package or a route handler
 			if !strings.HasSuffix(filePath, "_templ.go") {
+				action, validationErr := ExtractActionFromFile(file, filePath, pkg, config)
+				if validationErr != "" {
+					allValidationErrors = append(allValidationErrors, validationErr)
+				}
+				if action != nil {
+					templates = append(templates, *action)
+				}
+
+				if filepath.Base(filePath) == shared.RouteHandlerFileName {
+					handler, validationErr := ExtractRouteHandlerFromFile(file, filePath, pkg, config)
+					if validationErr != "" {
+						allValidationErrors = append(allValidationErrors, validationErr)
+					}
+					if handler != nil {
+						templates = append(templates, *handler)
+					}
+				}
 				continue
 			}
 
@@ -164,6 +182,9 @@ func ScanTemplatesWithPackages(config types.Config) ([]types.TemplateInfo, []str
 		}
 	}
 
+	templates, conflicts := dropConflictingRouteHandlers(templates)
+	allValidationErrors = append(allValidationErrors, conflicts...)
+
 	return templates, allValidationErrors, nil
 }
 
@@ -219,7 +240,11 @@ func ExtractTemplatesFromFile(file *ast.File, filePath string, pkg *packages.Pac
 		var requiresDataService bool
 		var dataServiceInterface, dataParameterType string
 
-		if hasParams && (functionName == "Page" || functionName == "Layout" || functionName == "Error") {
+		// Status error templates (error.404.templ, not-found.templ) take the same parameters as Error
+		isSpecial := functionName == "Page" || functionName == "Layout" || functionName == "Error" ||
+			isErrorTemplateFunction(functionName, filePath)
+
+		if hasParams && isSpecial {
 			// Check if first parameter is a data service type
 			if fnType.Params().Len() > 0 {
 				firstParam := fnType.Params().At(0)
@@ -238,7 +263,7 @@ func ExtractTemplatesFromFile(file *ast.File, filePath string, pkg *packages.Pac
 					fmt.Printf("      -> %s (has params, including for routing)\n", functionName)
 				}
 			}
-		} else if hasParams && functionName != "Page" && functionName != "Layout" && functionName != "Error" {
+		} else if hasParams && !isSpecial {
 			fmt.Printf("      -> %s (has params, skipping)\n", functionName)
 			continue
 		}
@@ -271,6 +296,7 @@ func ExtractTemplatesFromFile(file *ast.File, filePath string, pkg *packages.Pac
 			TemplateKey:  templateKey,
 			RoutePattern: routePattern,
 			HumanName:    humanName,
+			IsComponent:  !isSpecial,
 			
 			// Data Service Integration
 			RequiresDataService:  requiresDataService,
@@ -285,6 +311,193 @@ func ExtractTemplatesFromFile(file *ast.File, filePath string, pkg *packages.Pac
 	return templates, validationErrors, nil
 }
 
+// actionSignature is the signature of the Action function of a page package
+const actionSignature = "func Action(routerCtx interfaces.RouterContext) (interfaces.ActionResult, error)"
+
+// ExtractActionFromFile finds the exported Action function of a page package in a plain Go file.
+// An Action with another signature is reported as validation error.
+func ExtractActionFromFile(file *ast.File, filePath string, pkg *packages.Package, config types.Config) (*types.TemplateInfo, string) {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Name == nil || fn.Name.Name != shared.ActionFunctionName {
+			continue
+		}
+
+		obj := pkg.TypesInfo.Defs[fn.Name]
+		if obj == nil {
+			continue
+		}
+
+		// Compare without parameter names, so any name of the RouterContext parameter works
+		fnType, ok := obj.Type().(*gotypes.Signature)
+		if !ok || !isActionSignature(fnType) {
+			return nil, fmt.Sprintf("%s: %s must have the signature %s", filePath, fn.Name.Name, actionSignature)
+		}
+
+		packageName, importPath := utils.GetPackageInfo(filePath, config.ModuleName, config)
+		fmt.Printf("    Found page action: %s\n", filePath)
+
+		return &types.TemplateInfo{
+			FilePath:     filePath,
+			TemplatePath: convertToTemplatePath(filePath, config),
+			FunctionName: shared.ActionFunctionName,
+			PackageName:  packageName,
+			PackageAlias: utils.CreatePackageAlias(packageName, importPath, config),
+			ImportPath:   importPath,
+			TemplateKey:  uuid.New().String(),
+			HumanName:    utils.CreateHumanName(filePath, shared.ActionFunctionName),
+			IsAction:     true,
+		}, ""
+	}
+
+	return nil, ""
+}
+
+// isActionSignature checks for func(interfaces.RouterContext) (interfaces.ActionResult, error)
+func isActionSignature(fnType *gotypes.Signature) bool {
+	if fnType.Params().Len() != 1 || fnType.Results().Len() != 2 {
+		return false
+	}
+	return fnType.Params().At(0).Type().String() == "github.com/denkhaus/templ-router/pkg/interfaces.RouterContext" &&
+		fnType.Results().At(0).Type().String() == "github.com/denkhaus/templ-router/pkg/interfaces.ActionResult" &&
+		fnType.Results().At(1).Type().String() == "error"
+}
+
+// routeHandlerSignature is the signature of a Handler function in a route.go file
+const routeHandlerSignature = "func Handler(w http.ResponseWriter, r *http.Request)"
+
+// ExtractRouteHandlerFromFile finds the exported Handler of a route.go file: a function with the
+// signature of http.HandlerFunc, a variable implementing http.Handler or a struct type whose
+// pointer implements it. Any other Handler is reported as validation error.
+func ExtractRouteHandlerFromFile(file *ast.File, filePath string, pkg *packages.Package, config types.Config) (*types.TemplateInfo, string) {
+	for _, decl := range file.Decls {
+		var name *ast.Ident
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				name = d.Name
+			}
+		case *ast.GenDecl:
+			name = findDeclaredName(d, shared.RouteHandlerName)
+		}
+		if name == nil || name.Name != shared.RouteHandlerName {
+			continue
+		}
+
+		obj := pkg.TypesInfo.Defs[name]
+		if obj == nil {
+			continue
+		}
+
+		handlerKind, ok := routeHandlerKind(obj)
+		if !ok {
+			return nil, fmt.Sprintf("%s: %s must implement http.Handler or have the signature %s",
+				filePath, shared.RouteHandlerName, routeHandlerSignature)
+		}
+
+		packageName, importPath := utils.GetPackageInfo(filePath, config.ModuleName, config)
+		fmt.Printf("    Found route handler: %s (%s)\n", filePath, handlerKind)
+
+		return &types.TemplateInfo{
+			FilePath:     filePath,
+			TemplatePath: convertToTemplatePath(filePath, config),
+			FunctionName: shared.RouteHandlerName,
+			PackageName:  packageName,
+			PackageAlias: utils.CreatePackageAlias(packageName, importPath, config),
+			ImportPath:   importPath,
+			TemplateKey:  uuid.New().String(),
+			RoutePattern: utils.CreateRoutePattern(filePath, "Page", config),
+			HumanName:    utils.CreateHumanName(filePath, shared.RouteHandlerName),
+			IsHandler:    true,
+			HandlerKind:  handlerKind,
+		}, ""
+	}
+
+	return nil, ""
+}
+
+// findDeclaredName returns the identifier of a variable or type declaration named name
+func findDeclaredName(decl *ast.GenDecl, name string) *ast.Ident {
+	for _, spec := range decl.Specs {
+		switch s := spec.(type) {
+		case *ast.ValueSpec:
+			for _, ident := range s.Names {
+				if ident.Name == name {
+					return ident
+				}
+			}
+		case *ast.TypeSpec:
+			if s.Name.Name == name {
+				return s.Name
+			}
+		}
+	}
+	return nil
+}
+
+// routeHandlerKind tells how the registry turns a Handler declaration into an http.Handler
+func routeHandlerKind(obj gotypes.Object) (string, bool) {
+	switch o := obj.(type) {
+	case *gotypes.Func:
+		fnType, ok := o.Type().(*gotypes.Signature)
+		return "func", ok && fnType.Results().Len() == 0 && isServeHTTPParams(fnType)
+	case *gotypes.Var:
+		return "value", implementsHTTPHandler(o.Type())
+	case *gotypes.TypeName:
+		_, isStruct := o.Type().Underlying().(*gotypes.Struct)
+		return "type", isStruct && implementsHTTPHandler(gotypes.NewPointer(o.Type()))
+	}
+	return "", false
+}
+
+// implementsHTTPHandler checks if the method set of t has ServeHTTP(http.ResponseWriter, *http.Request)
+func implementsHTTPHandler(t gotypes.Type) bool {
+	selection := gotypes.NewMethodSet(t).Lookup(nil, "ServeHTTP")
+	if selection == nil {
+		return false
+	}
+	fnType, ok := selection.Obj().Type().(*gotypes.Signature)
+	return ok && fnType.Results().Len() == 0 && isServeHTTPParams(fnType)
+}
+
+// isServeHTTPParams checks for the parameters (http.ResponseWriter, *http.Request)
+func isServeHTTPParams(fnType *gotypes.Signature) bool {
+	return fnType.Params().Len() == 2 &&
+		fnType.Params().At(0).Type().String() == "net/http.ResponseWriter" &&
+		fnType.Params().At(1).Type().String() == "*net/http.Request"
+}
+
+// dropConflictingRouteHandlers removes route handlers whose directory also has a page:
+// both would answer on the same route, so the page wins and the handler is reported
+func dropConflictingRouteHandlers(templates []types.TemplateInfo) ([]types.TemplateInfo, []string) {
+	pageDirs := make(map[string]bool)
+	for _, tmpl := range templates {
+		if tmpl.FunctionName == "Page" && !tmpl.IsComponent {
+			pageDirs[filepath.Dir(tmpl.FilePath)] = true
+		}
+	}
+
+	var kept []types.TemplateInfo
+	var conflicts []string
+	for _, tmpl := range templates {
+		if tmpl.IsHandler && pageDirs[filepath.Dir(tmpl.FilePath)] {
+			conflicts = append(conflicts, fmt.Sprintf("%s: %s is ignored because the directory also has a page; move it to its own directory",
+				tmpl.FilePath, shared.RouteHandlerName))
+			continue
+		}
+		kept = append(kept, tmpl)
+	}
+
+	return kept, conflicts
+}
+
+// isErrorTemplateFunction reports whether functionName is the component of an error template file
+func isErrorTemplateFunction(functionName, filePath string) bool {
+	templateName := strings.TrimSuffix(filepath.Base(filePath), "_templ.go")
+	expected, ok := shared.ErrorTemplateFunctionName(templateName)
+	return ok && expected == functionName
+}
+
 // ScanSpecificPackage scans templates for a specific package
 func ScanSpecificPackage(config types.Config) ([]types.TemplateInfo, error) {
 	fmt.Printf("Scanning specific package: %s\n", config.PackageName)
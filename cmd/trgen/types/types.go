@@ -20,6 +20,10 @@ type TemplateInfo struct {
 	PackageAlias string
 	ImportPath   string
 	HumanName    string // Human-readable name for documentation
+	IsComponent  bool   // true for components that are not a page, layout or error template
+	IsAction     bool   // true for the Action function of a page package (FilePath is a plain Go file)
+	IsHandler    bool   // true for the Handler of a route.go file
+	HandlerKind  string // how the Handler is declared: "func", "value" or "type"
 	
 	// Data Service Integration
 	RequiresDataService  bool   // true if template has data parameter
@@ -38,3 +42,14 @@ type TemplateWithAlias struct {
 	TemplateInfo
 	PackageAlias string
 }
+
+// RouteBuilder describes a generated URL builder function for a page route
+type RouteBuilder struct {
+	FunctionName string // e.g., "LocaleUserId"
+	RoutePattern string // e.g., "/{locale}/user/{id}"
+	Params       string // Go parameter list, e.g., "locale string, id string"
+	URLExpr      string // Go expression building the path
+	HasLocale    bool   // true if the route has a {locale} segment
+	CtxParams    string // parameter list of the context-aware variant (without locale)
+	LocaleArg    string // identifier of the locale parameter, set from the request in the context-aware variant
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/denkhaus/templ-router/cmd/trgen/types"
+	"github.com/denkhaus/templ-router/pkg/shared"
 )
 
 // toSnakeCase converts CamelCase to snake_case for URL-friendly route names
@@ -166,7 +167,10 @@ func CreateRoutePattern(filePath, functionName string, config types.Config) stri
 		if part == "" || part == rootDir {
 			continue // Skip empty parts and root directory prefix
 		}
-		if strings.HasSuffix(part, "_") {
+		if paramName, optional, ok := shared.DirectoryToCatchAll(part); ok {
+			// slug___ -> {slug...}, slug____ -> {slug...?}
+			cleanParts = append(cleanParts, shared.CatchAllPatternSegment(paramName, optional))
+		} else if strings.HasSuffix(part, "_") {
 			paramName := strings.TrimSuffix(part, "_")
 			cleanParts = append(cleanParts, "{"+paramName+"}")
 		} else {
@@ -125,6 +125,27 @@ func TestCreateRoutePattern(t *testing.T) {
 			config:       types.Config{ScanPath: "app"},
 			expected:     "/{locale}/product/{id}",
 		},
+		{
+			name:         "Multi-parameter page",
+			filePath:     "/demo/app/locale_/org/orgId_/project/projectId_/page_templ.go",
+			functionName: "Page",
+			config:       types.Config{ScanPath: "app"},
+			expected:     "/{locale}/org/{orgId}/project/{projectId}",
+		},
+		{
+			name:         "Catch-all page",
+			filePath:     "/demo/app/docs/slug___/page_templ.go",
+			functionName: "Page",
+			config:       types.Config{ScanPath: "app"},
+			expected:     "/docs/{slug...}",
+		},
+		{
+			name:         "Optional catch-all page",
+			filePath:     "/demo/app/locale_/docs/slug____/page_templ.go",
+			functionName: "Page",
+			config:       types.Config{ScanPath: "app"},
+			expected:     "/{locale}/docs/{slug...?}",
+		},
 		{
 			name:         "Error template",
 			filePath:     "/demo/app/locale_/dashboard/error_templ.go",
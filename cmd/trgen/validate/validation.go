@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/denkhaus/templ-router/cmd/trgen/types"
+	"github.com/denkhaus/templ-router/pkg/shared"
 )
 
 // ValidateTemplatePath validates that the template file is in the correct location
@@ -89,6 +90,11 @@ func ValidateFunctionNaming(functionName, filePath string) error {
 			return fmt.Errorf("function '%s' found in 'error.templ' but should be 'Error'", functionName)
 		}
 	default:
+		// Status error templates must define their component, e.g. error.404.templ -> Error404
+		if expected, ok := shared.ErrorTemplateFunctionName(templateName); ok && functionName != expected {
+			return fmt.Errorf("function '%s' found in '%s.templ' but should be '%s'", functionName, templateName, expected)
+		}
+
 		// All other files are considered component files and can have any function names
 		// This makes the generator agnostic to specific app structures
 		return nil
@@ -253,6 +253,52 @@ func TestValidateTemplates(t *testing.T) {
 	}
 }
 
+func TestValidateFunctionNamingErrorTemplates(t *testing.T) {
+	tests := []struct {
+		name         string
+		functionName string
+		filePath     string
+		expectError  bool
+		errorMsg     string
+	}{
+		{name: "Generic error template", functionName: "Error", filePath: "app/error_templ.go"},
+		{name: "Numeric status template", functionName: "Error404", filePath: "app/admin/error.404_templ.go"},
+		{name: "Named status template", functionName: "NotFound", filePath: "app/admin/not-found_templ.go"},
+		{name: "Forbidden template", functionName: "Forbidden", filePath: "app/forbidden_templ.go"},
+		{
+			name:         "Wrong component in numeric status template",
+			functionName: "Error",
+			filePath:     "app/error.500_templ.go",
+			expectError:  true,
+			errorMsg:     "should only be in 'error.templ'",
+		},
+		{
+			name:         "Wrong component in named status template",
+			functionName: "Missing",
+			filePath:     "app/not-found_templ.go",
+			expectError:  true,
+			errorMsg:     "should be 'NotFound'",
+		},
+		{name: "Status component in a component file", functionName: "NotFound", filePath: "app/widgets_templ.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFunctionNaming(tt.functionName, tt.filePath)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				} else if !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("Expected error message to contain %q, got %q", tt.errorMsg, err.Error())
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
 // Helper function to check if a string contains a substring
 func contains(s, substr string) bool {
 	return strings.Contains(s, substr)
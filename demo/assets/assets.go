@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
-	"github.com/go-chi/chi/v5"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -79,20 +78,17 @@ func (p *assetsServiceImpl) createAssetHandler() http.HandlerFunc {
 }
 
 func (p *assetsServiceImpl) route() string {
-	return fmt.Sprintf("/%s/*", p.assetRouteName)
+	return fmt.Sprintf("/%s/{path...}", p.assetRouteName)
 }
 
-func (p *assetsServiceImpl) prefix() string {
-	return fmt.Sprintf("/%s/", p.assetRouteName)
-}
-
-func (p *assetsServiceImpl) SetupRoutes(mux *chi.Mux) {
-	assetHandler := p.createAssetHandler()
-	mux.Handle(p.route(), http.StripPrefix(p.prefix(), assetHandler))
-}
-
-func (p *assetsServiceImpl) SetupRoutesWithRouter(mux chi.Router) {
+// SetupRoutes serves the assets below the asset route name, e.g. /assets/css/main.css
+func (p *assetsServiceImpl) SetupRoutes(router interfaces.RouterAdapter) error {
 	assetHandler := p.createAssetHandler()
-	// Handle assets under locale paths (e.g., /en/assets/*)
-	mux.Handle(p.route(), http.StripPrefix(p.prefix(), assetHandler))
+	return router.Handle(http.MethodGet, p.route(), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Serve the file path relative to the asset route, independent of any mount prefix
+		assetRequest := r.Clone(r.Context())
+		assetRequest.URL.Path = "/" + router.URLParam(r, "path")
+		assetRequest.URL.RawPath = ""
+		assetHandler.ServeHTTP(w, assetRequest)
+	}))
 }
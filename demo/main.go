@@ -113,14 +113,7 @@ func startupClean(ctx context.Context) error {
 			WithContext("component", "router_initialization")
 	}
 
-	// Configure router middleware FIRST (before any routes or other middleware)
-	if err := cleanRouter.GetMiddlewareSetup().GetRouterMiddleware().ConfigureRouterMiddleware(mux); err != nil {
-		return shared.NewServiceError("Failed to configure router middleware").
-			WithCause(err).
-			WithContext("component", "router_middleware")
-	}
-
-	// Add auth context middleware AFTER router middleware
+	// Add auth context middleware
 	authMiddleware, err := middleware.NewAuthContextMiddleware(container.GetInjector())
 	if err != nil {
 		return shared.NewServiceError("Failed to create auth middleware").
@@ -168,9 +161,17 @@ func startupClean(ctx context.Context) error {
 	// Log route information
 	logRouteInformation(cleanRouter, logger)
 
+	// Wrap the router with router-level middleware (trailing slash and clean path handling)
+	handler, err := cleanRouter.GetMiddlewareSetup().GetRouterMiddleware().ConfigureRouterMiddleware(mux)
+	if err != nil {
+		return shared.NewServiceError("Failed to configure router middleware").
+			WithCause(err).
+			WithContext("component", "router_middleware")
+	}
+
 	// Start server
 	logger.Info("Starting Clean Architecture Demo Server on 0.0.0.0:8084")
-	if err := http.ListenAndServe("0.0.0.0:8084", mux); err != nil {
+	if err := http.ListenAndServe("0.0.0.0:8084", handler); err != nil {
 		return shared.NewServiceError("Failed to start HTTP server").
 			WithCause(err).
 			WithContext("component", "http_server").
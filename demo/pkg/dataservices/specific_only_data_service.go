@@ -32,10 +32,8 @@ func NewSpecificOnlyDataService(injector do.Injector) (SpecificOnlyDataService,
 // GetSpecificData retrieves data - this is the ONLY method available
 // No GetData method is implemented
 func (s *specificOnlyDataServiceImpl) GetSpecificData(routerCtx interfaces.RouterContext) (*SpecificData, error) {
+	// The router only serves declared locales (dynamic.parameters.locale)
 	locale := routerCtx.GetURLParam("locale")
-	if locale == "" {
-		locale = "en"
-	}
 
 	// Return demo data showing that the specific method was called
 	data := &SpecificData{
@@ -40,11 +40,8 @@ func NewUserDataService(injector do.Injector) (UserDataService, error) {
 
 // GetData retrieves user data based on route parameters and query parameters
 func (s *userDataServiceImpl) GetData(routerCtx interfaces.RouterContext) (*UserData, error) {
-	// URL Parameters
+	// The router only serves declared locales (dynamic.parameters.locale)
 	locale := routerCtx.GetURLParam("locale")
-	if locale == "" {
-		locale = "en"
-	}
 
 	// Query Parameters - NEW: RouterContext query parameter support!
 	page := routerCtx.GetQueryParam("page")
@@ -102,11 +99,8 @@ func (s *userDataServiceImpl) GetData(routerCtx interfaces.RouterContext) (*User
 
 // GetUserData is the specific method that should be called preferentially over GetData
 func (s *userDataServiceImpl) GetUserData(routerCtx interfaces.RouterContext) (*UserData, error) {
-	// URL Parameters
+	// The router only serves declared locales (dynamic.parameters.locale)
 	locale := routerCtx.GetURLParam("locale")
-	if locale == "" {
-		locale = "en"
-	}
 
 	// Query Parameters - NEW: RouterContext query parameter support!
 	page := routerCtx.GetQueryParam("page")
@@ -29,15 +29,9 @@ func NewUserWithIdDataService(injector do.Injector) (UserWithIdDataService, erro
 // GetSpecificData retrieves data - this is the ONLY method available
 // No GetData method is implemented
 func (s *userWithIdDataServiceImpl) GetUserWithIdData(routerCtx interfaces.RouterContext) (*UserWithIdData, error) {
+	// Both parameters are validated by the router (dynamic.parameters in page.templ.yaml)
 	locale := routerCtx.GetURLParam("locale")
-	if locale == "" {
-		locale = "undefined"
-	}
-
 	userId := routerCtx.GetURLParam("userId")
-	if userId == "" {
-		userId = "undefined"
-	}
 
 	// Return demo data showing that the specific method was called
 	data := &UserWithIdData{
@@ -1,6 +1,12 @@
 package config
 
-import "time"
+import (
+	"net/url"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+)
 
 // Server configuration methods
 func (cs *configService) GetServerHost() string {
@@ -123,6 +129,10 @@ func (cs *configService) GetSessionExpiry() time.Duration {
 	return cs.config.Auth.SessionExpiry
 }
 
+func (cs *configService) GetSessionRememberExpiry() time.Duration {
+	return cs.config.Auth.SessionRememberExpiry
+}
+
 func (cs *configService) IsSessionSecure() bool {
 	return cs.config.Auth.SessionSecure
 }
@@ -182,6 +192,10 @@ func (cs *configService) GetSignOutSuccessRoute() string {
 }
 
 // Security configuration methods
+func (cs *configService) IsCSRFEnabled() bool {
+	return cs.config.Security.EnableCSRF
+}
+
 func (cs *configService) GetCSRFSecret() string {
 	return cs.config.Security.CSRFSecret
 }
@@ -198,6 +212,10 @@ func (cs *configService) GetCSRFSameSite() string {
 	return cs.config.Security.CSRFSameSite
 }
 
+func (cs *configService) GetCSRFExemptPaths() []string {
+	return cs.config.Security.CSRFExemptPaths
+}
+
 func (cs *configService) IsRateLimitEnabled() bool {
 	return cs.config.Security.EnableRateLimit
 }
@@ -206,6 +224,14 @@ func (cs *configService) GetRateLimitRequests() int {
 	return cs.config.Security.RateLimitRequests
 }
 
+func (cs *configService) IsRateLimitAllRoutesEnabled() bool {
+	return cs.config.Security.RateLimitAllRoutes
+}
+
+func (cs *configService) GetTrustedProxies() []string {
+	return cs.config.Security.TrustedProxies
+}
+
 func (cs *configService) AreSecurityHeadersEnabled() bool {
 	return cs.config.Security.EnableSecurityHeaders
 }
@@ -218,6 +244,10 @@ func (cs *configService) GetHSTSMaxAge() int {
 	return cs.config.Security.HSTSMaxAge
 }
 
+func (cs *configService) GetContentSecurityPolicy() string {
+	return cs.config.Security.ContentSecurityPolicy
+}
+
 // Logging configuration methods
 func (cs *configService) GetLogLevel() string {
 	return cs.config.Logging.Level
@@ -300,3 +330,45 @@ func (cs *configService) GetRouterEnableSlashRedirect() bool {
 func (cs *configService) GetRouterEnableMethodNotAllowed() bool {
 	return cs.config.Router.EnableMethodNotAllowed
 }
+
+// GetRouterParameterValidationStatus returns the HTTP status for invalid dynamic parameters
+func (cs *configService) GetRouterParameterValidationStatus() int {
+	if cs.config.Router.ParameterValidationStatus == 0 {
+		return 404
+	}
+	return cs.config.Router.ParameterValidationStatus
+}
+
+// GetRouterValidationMode returns the startup route validation mode (strict, warn or off)
+func (cs *configService) GetRouterValidationMode() string {
+	if cs.config.Router.ValidationMode == "" {
+		return interfaces.ValidationModeWarn
+	}
+	return cs.config.Router.ValidationMode
+}
+
+// GetRouterBasePath returns the normalized mount prefix of the router ("" for the root).
+// Falls back to the path of the server base URL when no base path is configured.
+func (cs *configService) GetRouterBasePath() string {
+	if basePath := shared.NormalizeBasePath(cs.config.Router.BasePath); basePath != "" {
+		return basePath
+	}
+
+	baseURL, err := url.Parse(cs.config.Server.BaseURL)
+	if err != nil {
+		return ""
+	}
+	return shared.NormalizeBasePath(baseURL.Path)
+}
+
+// GetRouterRenderMode returns the page render mode (buffered, streaming or direct).
+// Defaults to buffered in production and direct otherwise.
+func (cs *configService) GetRouterRenderMode() string {
+	if cs.config.Router.RenderMode != "" {
+		return cs.config.Router.RenderMode
+	}
+	if cs.config.IsProduction() {
+		return interfaces.RenderModeBuffered
+	}
+	return interfaces.RenderModeDirect
+}
@@ -45,6 +45,7 @@ func (c *configImpl) LogSummary() {
 	fmt.Printf("  Verification Token Expiry: %s\n", c.Auth.VerificationTokenExpiry)
 	fmt.Printf("  Session Cookie Name: %s\n", c.Auth.SessionCookieName)
 	fmt.Printf("  Session Expiry: %s\n", c.Auth.SessionExpiry)
+	fmt.Printf("  Session Remember Expiry: %s\n", c.Auth.SessionRememberExpiry)
 	fmt.Printf("  Session Secure: %t\n", c.Auth.SessionSecure)
 	fmt.Printf("  Session HTTP Only: %t\n", c.Auth.SessionHttpOnly)
 	fmt.Printf("  Session Same Site: %s\n", c.Auth.SessionSameSite)
@@ -92,15 +93,20 @@ func (c *configImpl) LogSummary() {
 
 	// Security Configuration
 	fmt.Printf("Security:\n")
+	fmt.Printf("  Enable CSRF: %t\n", c.Security.EnableCSRF)
 	fmt.Printf("  CSRF Secret: %s\n", maskSensitive(c.Security.CSRFSecret))
 	fmt.Printf("  CSRF Secure: %t\n", c.Security.CSRFSecure)
 	fmt.Printf("  CSRF HTTP Only: %t\n", c.Security.CSRFHttpOnly)
 	fmt.Printf("  CSRF Same Site: %s\n", c.Security.CSRFSameSite)
+	fmt.Printf("  CSRF Exempt Paths: %v\n", c.Security.CSRFExemptPaths)
 	fmt.Printf("  Enable Rate Limit: %t\n", c.Security.EnableRateLimit)
 	fmt.Printf("  Rate Limit Requests: %d\n", c.Security.RateLimitRequests)
+	fmt.Printf("  Rate Limit All Routes: %t\n", c.Security.RateLimitAllRoutes)
+	fmt.Printf("  Trusted Proxies: %v\n", c.Security.TrustedProxies)
 	fmt.Printf("  Enable Security Headers: %t\n", c.Security.EnableSecurityHeaders)
 	fmt.Printf("  Enable HSTS: %t\n", c.Security.EnableHSTS)
 	fmt.Printf("  HSTS Max Age: %d\n", c.Security.HSTSMaxAge)
+	fmt.Printf("  Content Security Policy: %s\n", c.Security.ContentSecurityPolicy)
 
 	// Logging Configuration
 	fmt.Printf("Logging:\n")
@@ -316,6 +316,7 @@ func TestDefaultValues(t *testing.T) {
 	assert.Equal(t, 8080, service.GetServerPort())
 	assert.Equal(t, "http://localhost:8080", service.GetServerBaseURL())
 	assert.Equal(t, 30*time.Second, service.GetServerReadTimeout())
+	assert.Equal(t, "", service.GetRouterBasePath())
 
 	assert.Equal(t, "localhost", service.GetDatabaseHost())
 	assert.Equal(t, 5432, service.GetDatabasePort())
@@ -439,6 +440,114 @@ func TestProductionDetection(t *testing.T) {
 	}
 }
 
+func TestRouterBasePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		envVars  map[string]string
+		expected string
+	}{
+		{
+			name:     "Explicit base path is normalized",
+			envVars:  map[string]string{"TR_ROUTER_BASE_PATH": "portal/"},
+			expected: "/portal",
+		},
+		{
+			name:     "Falls back to the path of the server base URL",
+			envVars:  map[string]string{"TR_SERVER_BASE_URL": "https://example.com/portal/"},
+			expected: "/portal",
+		},
+		{
+			name: "Explicit base path wins over the server base URL",
+			envVars: map[string]string{
+				"TR_ROUTER_BASE_PATH": "/app",
+				"TR_SERVER_BASE_URL":  "https://example.com/portal",
+			},
+			expected: "/app",
+		},
+		{
+			name:     "Root base path",
+			envVars:  map[string]string{"TR_ROUTER_BASE_PATH": "/"},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearTestEnv(t)
+			for key, value := range tt.envVars {
+				os.Setenv(key, value)
+			}
+			defer clearTestEnv(t)
+
+			injector := do.New()
+			defer injector.Shutdown()
+
+			service, err := NewConfigService("TR")(injector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, service.GetRouterBasePath())
+		})
+	}
+}
+
+func TestRouterRenderMode(t *testing.T) {
+	production := map[string]string{
+		"TR_ENVIRONMENT_KIND":     "production",
+		"TR_SERVER_BASE_URL":      "https://myapp.com",
+		"TR_SECURITY_CSRF_SECRET": "production-secret-key",
+	}
+
+	tests := []struct {
+		name     string
+		envVars  map[string]string
+		expected string
+	}{
+		{
+			name:     "Development defaults to direct rendering",
+			envVars:  map[string]string{},
+			expected: "direct",
+		},
+		{
+			name:     "Production defaults to buffered rendering",
+			envVars:  production,
+			expected: "buffered",
+		},
+		{
+			name:     "Explicit render mode",
+			envVars:  map[string]string{"TR_ROUTER_RENDER_MODE": "streaming"},
+			expected: "streaming",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearTestEnv(t)
+			for key, value := range tt.envVars {
+				os.Setenv(key, value)
+			}
+			defer clearTestEnv(t)
+
+			injector := do.New()
+			defer injector.Shutdown()
+
+			service, err := NewConfigService("TR")(injector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, service.GetRouterRenderMode())
+		})
+	}
+
+	t.Run("Invalid render mode", func(t *testing.T) {
+		clearTestEnv(t)
+		os.Setenv("TR_ROUTER_RENDER_MODE", "chunked")
+		defer clearTestEnv(t)
+
+		injector := do.New()
+		defer injector.Shutdown()
+
+		_, err := NewConfigService("TR")(injector)
+		assert.Error(t, err)
+	})
+}
+
 // Helper function to clear test environment variables
 func clearTestEnv(t *testing.T) {
 	// List of environment variables to clear for clean test state
@@ -462,6 +571,7 @@ func clearTestEnv(t *testing.T) {
 		"TR_LAYOUT_LAYOUT_FILE_NAME", "TR_LAYOUT_TEMPLATE_EXTENSION", "TR_LAYOUT_METADATA_EXTENSION", "TR_LAYOUT_ENABLE_INHERITANCE",
 		"TR_TEMPLATE_GENERATOR_OUTPUT_DIR", "TR_TEMPLATE_GENERATOR_PACKAGE_NAME",
 		"TR_ENVIRONMENT_KIND", "TR_CONFIG_PRINT_SUMMARY",
+		"TR_ROUTER_PARAMETER_VALIDATION_STATUS", "TR_ROUTER_VALIDATION_MODE", "TR_ROUTER_BASE_PATH", "TR_ROUTER_RENDER_MODE",
 		// Also clear system environment variables that might interfere with defaults
 		"USER", "NAME",
 	}
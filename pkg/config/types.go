@@ -64,6 +64,18 @@ type RouterConfig struct {
 	
 	// Enable method not allowed handler
 	EnableMethodNotAllowed bool `envconfig:"ENABLE_METHOD_NOT_ALLOWED" default:"true"`
+
+	// HTTP status for dynamic parameters failing their .templ.yaml validation (404 or 400)
+	ParameterValidationStatus int `envconfig:"PARAMETER_VALIDATION_STATUS" default:"404"`
+
+	// Startup route validation: strict aborts on errors, warn only logs, off skips validation
+	ValidationMode string `envconfig:"VALIDATION_MODE" default:"warn"`
+
+	// Mount prefix of all routes, e.g. /portal (defaults to the path of SERVER_BASE_URL)
+	BasePath string `envconfig:"BASE_PATH" default:""`
+
+	// Page rendering: buffered, streaming or direct (defaults to buffered in production, direct otherwise)
+	RenderMode string `envconfig:"RENDER_MODE" default:""`
 }
 
 type ConfigConfig struct {
@@ -86,12 +98,13 @@ type AuthConfig struct {
 	RequireEmailVerification bool          `envconfig:"REQUIRE_EMAIL_VERIFICATION" default:"true"`
 	VerificationTokenExpiry  time.Duration `envconfig:"VERIFICATION_TOKEN_EXPIRY" default:"24h"`
 
-	// Session settings
-	SessionCookieName string        `envconfig:"SESSION_COOKIE_NAME" default:"session_id"`
-	SessionExpiry     time.Duration `envconfig:"SESSION_EXPIRY" default:"24h"`
-	SessionSecure     bool          `envconfig:"SESSION_SECURE" default:"false"`
-	SessionHttpOnly   bool          `envconfig:"SESSION_HTTP_ONLY" default:"true"`
-	SessionSameSite   string        `envconfig:"SESSION_SAME_SITE" default:"lax"`
+	// Session settings, SessionRememberExpiry replaces SessionExpiry for sign ins with "remember me"
+	SessionCookieName     string        `envconfig:"SESSION_COOKIE_NAME" default:"session_id"`
+	SessionExpiry         time.Duration `envconfig:"SESSION_EXPIRY" default:"24h"`
+	SessionRememberExpiry time.Duration `envconfig:"SESSION_REMEMBER_EXPIRY" default:"720h"`
+	SessionSecure         bool          `envconfig:"SESSION_SECURE" default:"false"`
+	SessionHttpOnly       bool          `envconfig:"SESSION_HTTP_ONLY" default:"true"`
+	SessionSameSite       string        `envconfig:"SESSION_SAME_SITE" default:"lax"`
 
 	// Password settings
 	MinPasswordLength   int  `envconfig:"MIN_PASSWORD_LENGTH" default:"8"`
@@ -134,19 +147,30 @@ type EmailConfig struct {
 // SecurityConfig holds security-related configuration
 type SecurityConfig struct {
 	// CSRF protection
+	EnableCSRF   bool   `envconfig:"ENABLE_CSRF" default:"true"`
 	CSRFSecret   string `envconfig:"CSRF_SECRET" default:"change-me-in-production"`
 	CSRFSecure   bool   `envconfig:"CSRF_SECURE" default:"false"`
 	CSRFHttpOnly bool   `envconfig:"CSRF_HTTP_ONLY" default:"true"`
 	CSRFSameSite string `envconfig:"CSRF_SAME_SITE" default:"strict"`
 
+	// Path prefixes below the base path that are not checked for CSRF tokens, e.g. webhooks
+	CSRFExemptPaths []string `envconfig:"CSRF_EXEMPT_PATHS" default:""`
+
 	// Rate limiting
-	EnableRateLimit   bool `envconfig:"ENABLE_RATE_LIMIT" default:"true"`
-	RateLimitRequests int  `envconfig:"RATE_LIMIT_REQUESTS" default:"100"`
+	EnableRateLimit    bool `envconfig:"ENABLE_RATE_LIMIT" default:"true"`
+	RateLimitRequests  int  `envconfig:"RATE_LIMIT_REQUESTS" default:"100"`
+	RateLimitAllRoutes bool `envconfig:"RATE_LIMIT_ALL_ROUTES" default:"false"`
+
+	// Proxies (IPs or CIDRs) whose X-Forwarded-For and X-Real-IP headers name the client
+	TrustedProxies []string `envconfig:"TRUSTED_PROXIES" default:""`
 
 	// Security headers
 	EnableSecurityHeaders bool `envconfig:"ENABLE_SECURITY_HEADERS" default:"true"`
 	EnableHSTS            bool `envconfig:"ENABLE_HSTS" default:"false"`
 	HSTSMaxAge            int  `envconfig:"HSTS_MAX_AGE" default:"31536000"`
+
+	// Content-Security-Policy of all routes, {nonce} is replaced with the nonce of the request
+	ContentSecurityPolicy string `envconfig:"CONTENT_SECURITY_POLICY" default:""`
 }
 
 // LoggingConfig holds logging-related configuration
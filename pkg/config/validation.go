@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	
+	"github.com/denkhaus/templ-router/pkg/interfaces"
 	"github.com/denkhaus/templ-router/pkg/shared"
 )
 
@@ -87,6 +89,54 @@ func (c *configImpl) Validate() error {
 			WithContext("value", c.Security.RateLimitRequests).
 			WithContext("minimum", 1)
 	}
+	if _, err := shared.ParseTrustedProxies(c.Security.TrustedProxies); err != nil {
+		return shared.NewValidationError("Invalid trusted proxies").
+			WithDetails(err.Error()).
+			WithContext("field", "security.trusted_proxies").
+			WithContext("value", c.Security.TrustedProxies)
+	}
+
+	// Validate router configuration
+	// Zero means unset and falls back to 404
+	switch c.Router.ParameterValidationStatus {
+	case 0, 400, 404:
+	default:
+		return shared.NewValidationError("Invalid parameter validation status").
+			WithDetails(fmt.Sprintf("Status %d is not supported, use 404 or 400", c.Router.ParameterValidationStatus)).
+			WithContext("field", "router.parameter_validation_status").
+			WithContext("value", c.Router.ParameterValidationStatus).
+			WithContext("allowed_values", "400, 404")
+	}
+
+	// Empty means unset and falls back to warn
+	switch c.Router.ValidationMode {
+	case "", interfaces.ValidationModeStrict, interfaces.ValidationModeWarn, interfaces.ValidationModeOff:
+	default:
+		return shared.NewValidationError("Invalid router validation mode").
+			WithDetails(fmt.Sprintf("Mode %q is not supported, use strict, warn or off", c.Router.ValidationMode)).
+			WithContext("field", "router.validation_mode").
+			WithContext("value", c.Router.ValidationMode).
+			WithContext("allowed_values", "strict, warn, off")
+	}
+
+	// Empty means unset and falls back to the environment default
+	switch c.Router.RenderMode {
+	case "", interfaces.RenderModeDirect, interfaces.RenderModeBuffered, interfaces.RenderModeStreaming:
+	default:
+		return shared.NewValidationError("Invalid router render mode").
+			WithDetails(fmt.Sprintf("Mode %q is not supported, use buffered, streaming or direct", c.Router.RenderMode)).
+			WithContext("field", "router.render_mode").
+			WithContext("value", c.Router.RenderMode).
+			WithContext("allowed_values", "buffered, streaming, direct")
+	}
+
+	// The base path is a static prefix, patterns are not supported
+	if strings.ContainsAny(c.Router.BasePath, "{}*?#") {
+		return shared.NewValidationError("Invalid router base path").
+			WithDetails(fmt.Sprintf("Base path %q must be a static path like /portal", c.Router.BasePath)).
+			WithContext("field", "router.base_path").
+			WithContext("value", c.Router.BasePath)
+	}
 
 	return nil
 }
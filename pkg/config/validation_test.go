@@ -251,6 +251,38 @@ func TestConfigValidation(t *testing.T) {
 			},
 			expectError: false,
 		},
+		// Router validation mode tests
+		{
+			name: "valid router validation mode - strict",
+			envVars: map[string]string{
+				"TR_ROUTER_VALIDATION_MODE": "strict",
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid router validation mode",
+			envVars: map[string]string{
+				"TR_ROUTER_VALIDATION_MODE": "panic",
+			},
+			expectError: true,
+			errorMsg:    "Invalid router validation mode",
+		},
+		// Router base path tests
+		{
+			name: "valid router base path",
+			envVars: map[string]string{
+				"TR_ROUTER_BASE_PATH": "/portal",
+			},
+			expectError: false,
+		},
+		{
+			name: "router base path with pattern",
+			envVars: map[string]string{
+				"TR_ROUTER_BASE_PATH": "/{tenant}",
+			},
+			expectError: true,
+			errorMsg:    "Invalid router base path",
+		},
 		// Multiple validation errors (should return first error)
 		{
 			name: "multiple validation errors",
@@ -10,6 +10,7 @@ import (
 	"github.com/denkhaus/templ-router/pkg/services/auth"
 	"github.com/denkhaus/templ-router/pkg/services/cache"
 	"github.com/denkhaus/templ-router/pkg/services/logger"
+	"github.com/denkhaus/templ-router/pkg/services/ratelimit"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -53,6 +54,7 @@ func (c *Container) RegisterRouterServices(configPraefix string) {
 	// Register stores - constructors already return interfaces! (pluggable)
 	// Session store stays in router (user-type agnostic)
 	do.Provide(c.injector, auth.NewInMemorySessionStore)
+	do.Provide(c.injector, ratelimit.NewInMemoryRateLimiter)
 
 	// Internal services (these can remain concrete for now)
 	do.Provide(c.injector, services.NewInMemoryTranslationStore)
@@ -85,7 +87,14 @@ func (c *Container) RegisterRouterServices(configPraefix string) {
 
 	do.Provide(c.injector, middleware.NewAuthMiddleware)
 	do.Provide(c.injector, middleware.NewI18nMiddleware)
+	do.Provide(c.injector, middleware.NewParameterValidationMiddleware)
 	do.Provide(c.injector, middleware.NewTemplateMiddleware)
+	do.Provide(c.injector, middleware.NewContentNegotiationMiddleware)
+	do.Provide(c.injector, middleware.NewActionMiddleware)
+	do.Provide(c.injector, middleware.NewRecoveryMiddleware)
+	do.Provide(c.injector, middleware.NewRateLimitMiddleware)
+	do.Provide(c.injector, middleware.NewCSRFMiddleware)
+	do.Provide(c.injector, middleware.NewSecurityHeadersMiddleware)
 	do.Provide(c.injector, middleware.NewRouterMiddleware)
 
 	do.Provide(c.injector, pipeline.NewHandlerPipeline)
@@ -6,7 +6,6 @@ import (
 
 	"github.com/a-h/templ"
 	"github.com/denkhaus/templ-router/pkg/interfaces"
-	"github.com/go-chi/chi/v5"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -186,6 +185,23 @@ func (m *mockTemplateRegistry) GetTemplateByRoute(route string) (templ.Component
 	return nil, nil
 }
 
+func (m *mockTemplateRegistry) GetComponentTemplateKey(componentKey string) (string, bool) {
+	return "", false
+}
+
+// GetAction retrieves the Action function of a page package
+func (m *mockTemplateRegistry) GetAction(actionKey string) (interfaces.ActionFunc, bool) {
+	return nil, false
+}
+
+func (m *mockTemplateRegistry) GetRouteHandlerMapping() map[string]string {
+	return map[string]string{}
+}
+
+func (m *mockTemplateRegistry) GetRouteHandler(handlerKey string) (http.Handler, bool) {
+	return nil, false
+}
+
 func (m *mockTemplateRegistry) RequiresDataService(key string) bool {
 	return false
 }
@@ -196,8 +212,7 @@ func (m *mockTemplateRegistry) GetDataServiceInfo(key string) (interfaces.DataSe
 
 type mockAssetsService struct{}
 
-func (m *mockAssetsService) SetupRoutes(router *chi.Mux) {}
-func (m *mockAssetsService) SetupRoutesWithRouter(router chi.Router) {}
+func (m *mockAssetsService) SetupRoutes(router interfaces.RouterAdapter) error { return nil }
 
 // Mock UserStore for testing
 type mockUserStore struct{}
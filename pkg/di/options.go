@@ -29,6 +29,20 @@ func WithUserStore(userStore interfaces.UserStore) ApplicationOption {
 	}
 }
 
+// WithSessionStore sets a custom session store, e.g. the SQL store of pkg/services/sqlstore
+func WithSessionStore(sessionStore interfaces.SessionStore) ApplicationOption {
+	return func(c *Container) {
+		do.OverrideValue(c.injector, sessionStore)
+	}
+}
+
+// WithRateLimiter sets a custom rate limiter, e.g. one backed by a store shared between instances
+func WithRateLimiter(rateLimiter interfaces.RateLimiter) ApplicationOption {
+	return func(c *Container) {
+		do.OverrideValue(c.injector, rateLimiter)
+	}
+}
+
 // WithAuthHandlers sets custom authentication handlers
 func WithAuthHandlers(authHandlers interfaces.AuthHandlers) ApplicationOption {
 	return func(c *Container) {
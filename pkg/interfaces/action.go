@@ -0,0 +1,47 @@
+package interfaces
+
+import "net/url"
+
+// ActionFunc handles the form submissions (POST, PUT, DELETE) of a page on the page route.
+// trgen registers the exported Action function of a page package:
+//
+//	func Action(routerCtx interfaces.RouterContext) (interfaces.ActionResult, error)
+type ActionFunc func(routerCtx RouterContext) (ActionResult, error)
+
+// ActionResult tells the router how to answer a form submission
+type ActionResult struct {
+	// RedirectURL answers with 303 See Other (HX-Redirect for HTMX requests), e.g. after success.
+	// Root-relative paths like "/contact/thanks" are prefixed with the base path of the router.
+	RedirectURL string `json:"redirect_url,omitempty"`
+
+	// Errors are the validation errors of the submission keyed by form field.
+	// The page is rendered again with them, with status 422 unless Status is set.
+	Errors map[string]string `json:"errors,omitempty"`
+
+	// Status of the page rendered again (default 200 without errors)
+	Status int `json:"status,omitempty"`
+
+	// Values are the submitted form values, set by the router to fill the form again
+	Values url.Values `json:"-"`
+}
+
+// HasErrors reports whether the submission failed validation
+func (r *ActionResult) HasErrors() bool {
+	return r != nil && len(r.Errors) > 0
+}
+
+// FieldError returns the validation error of a form field ("" if it is valid)
+func (r *ActionResult) FieldError(field string) string {
+	if r == nil {
+		return ""
+	}
+	return r.Errors[field]
+}
+
+// Value returns the submitted value of a form field
+func (r *ActionResult) Value(field string) string {
+	if r == nil {
+		return ""
+	}
+	return r.Values.Get(field)
+}
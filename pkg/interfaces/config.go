@@ -44,6 +44,7 @@ type ConfigService interface {
 	GetVerificationTokenExpiry() time.Duration
 	GetSessionCookieName() string
 	GetSessionExpiry() time.Duration
+	GetSessionRememberExpiry() time.Duration
 	IsSessionSecure() bool
 	IsSessionHttpOnly() bool
 	GetSessionSameSite() string
@@ -64,15 +65,20 @@ type ConfigService interface {
 	GetSignOutSuccessRoute() string
 
 	// Security configuration
+	IsCSRFEnabled() bool
 	GetCSRFSecret() string
 	IsCSRFSecure() bool
 	IsCSRFHttpOnly() bool
 	GetCSRFSameSite() string
+	GetCSRFExemptPaths() []string
 	IsRateLimitEnabled() bool
 	GetRateLimitRequests() int
+	IsRateLimitAllRoutesEnabled() bool
+	GetTrustedProxies() []string
 	AreSecurityHeadersEnabled() bool
 	IsHSTSEnabled() bool
 	GetHSTSMaxAge() int
+	GetContentSecurityPolicy() string
 
 	// Logging configuration
 	GetLogLevel() string
@@ -100,4 +106,8 @@ type ConfigService interface {
 	GetRouterEnableTrailingSlash() bool
 	GetRouterEnableSlashRedirect() bool
 	GetRouterEnableMethodNotAllowed() bool
+	GetRouterParameterValidationStatus() int
+	GetRouterValidationMode() string
+	GetRouterBasePath() string
+	GetRouterRenderMode() string
 }
@@ -42,6 +42,7 @@ func NewMockConfigService() *MockConfigService {
 			"auth.verification_token_expiry":   24 * time.Hour,
 			"auth.session_cookie_name":        "session_id",
 			"auth.session_expiry":             7 * 24 * time.Hour,
+			"auth.session_remember_expiry":    30 * 24 * time.Hour,
 			"auth.session_secure":             true,
 			"auth.session_http_only":          true,
 			"auth.session_same_site":          "Strict",
@@ -52,15 +53,20 @@ func NewMockConfigService() *MockConfigService {
 			"auth.default_admin_password":     "admin123",
 			"auth.default_admin_first_name":   "Admin",
 			"auth.default_admin_last_name":    "User",
+			"security.csrf_enabled":           true,
 			"security.csrf_secret":            "csrf-secret-key",
 			"security.csrf_secure":            true,
 			"security.csrf_http_only":         true,
 			"security.csrf_same_site":         "Strict",
+			"security.csrf_exempt_paths":      []string{"/webhooks"},
 			"security.rate_limit_enabled":     true,
 			"security.rate_limit_requests":    100,
+			"security.rate_limit_all_routes":  false,
+			"security.trusted_proxies":        []string{},
 			"security.headers_enabled":        true,
 			"security.hsts_enabled":           true,
 			"security.hsts_max_age":           31536000,
+			"security.csp":                    "default-src 'self'",
 			"logging.level":                   "info",
 			"logging.format":                  "json",
 			"logging.output":                  "stdout",
@@ -197,6 +203,10 @@ func (m *MockConfigService) GetSessionExpiry() time.Duration {
 	return m.config["auth.session_expiry"].(time.Duration)
 }
 
+func (m *MockConfigService) GetSessionRememberExpiry() time.Duration {
+	return m.config["auth.session_remember_expiry"].(time.Duration)
+}
+
 func (m *MockConfigService) IsSessionSecure() bool {
 	return m.config["auth.session_secure"].(bool)
 }
@@ -237,6 +247,10 @@ func (m *MockConfigService) GetDefaultAdminLastName() string {
 	return m.config["auth.default_admin_last_name"].(string)
 }
 
+func (m *MockConfigService) IsCSRFEnabled() bool {
+	return m.config["security.csrf_enabled"].(bool)
+}
+
 func (m *MockConfigService) GetCSRFSecret() string {
 	return m.config["security.csrf_secret"].(string)
 }
@@ -253,6 +267,10 @@ func (m *MockConfigService) GetCSRFSameSite() string {
 	return m.config["security.csrf_same_site"].(string)
 }
 
+func (m *MockConfigService) GetCSRFExemptPaths() []string {
+	return m.config["security.csrf_exempt_paths"].([]string)
+}
+
 func (m *MockConfigService) IsRateLimitEnabled() bool {
 	return m.config["security.rate_limit_enabled"].(bool)
 }
@@ -261,6 +279,14 @@ func (m *MockConfigService) GetRateLimitRequests() int {
 	return m.config["security.rate_limit_requests"].(int)
 }
 
+func (m *MockConfigService) IsRateLimitAllRoutesEnabled() bool {
+	return m.config["security.rate_limit_all_routes"].(bool)
+}
+
+func (m *MockConfigService) GetTrustedProxies() []string {
+	return m.config["security.trusted_proxies"].([]string)
+}
+
 func (m *MockConfigService) AreSecurityHeadersEnabled() bool {
 	return m.config["security.headers_enabled"].(bool)
 }
@@ -273,6 +299,10 @@ func (m *MockConfigService) GetHSTSMaxAge() int {
 	return m.config["security.hsts_max_age"].(int)
 }
 
+func (m *MockConfigService) GetContentSecurityPolicy() string {
+	return m.config["security.csp"].(string)
+}
+
 func (m *MockConfigService) GetLogLevel() string {
 	return m.config["logging.level"].(string)
 }
@@ -450,6 +480,7 @@ func TestConfigService_AuthConfiguration(t *testing.T) {
 		{"GetVerificationTokenExpiry", func() interface{} { return config.GetVerificationTokenExpiry() }, 24 * time.Hour},
 		{"GetSessionCookieName", func() interface{} { return config.GetSessionCookieName() }, "session_id"},
 		{"GetSessionExpiry", func() interface{} { return config.GetSessionExpiry() }, 7 * 24 * time.Hour},
+		{"GetSessionRememberExpiry", func() interface{} { return config.GetSessionRememberExpiry() }, 30 * 24 * time.Hour},
 		{"IsSessionSecure", func() interface{} { return config.IsSessionSecure() }, true},
 		{"IsSessionHttpOnly", func() interface{} { return config.IsSessionHttpOnly() }, true},
 		{"GetSessionSameSite", func() interface{} { return config.GetSessionSameSite() }, "Strict"},
@@ -479,9 +510,11 @@ func TestConfigService_SecurityConfiguration(t *testing.T) {
 		{"IsCSRFSecure", func() interface{} { return config.IsCSRFSecure() }, true},
 		{"IsRateLimitEnabled", func() interface{} { return config.IsRateLimitEnabled() }, true},
 		{"GetRateLimitRequests", func() interface{} { return config.GetRateLimitRequests() }, 100},
+		{"IsRateLimitAllRoutesEnabled", func() interface{} { return config.IsRateLimitAllRoutesEnabled() }, false},
 		{"AreSecurityHeadersEnabled", func() interface{} { return config.AreSecurityHeadersEnabled() }, true},
 		{"IsHSTSEnabled", func() interface{} { return config.IsHSTSEnabled() }, true},
 		{"GetHSTSMaxAge", func() interface{} { return config.GetHSTSMaxAge() }, 31536000},
+		{"GetContentSecurityPolicy", func() interface{} { return config.GetContentSecurityPolicy() }, "default-src 'self'"},
 	}
 
 	for _, tt := range tests {
@@ -536,3 +569,7 @@ func (m *MockConfigService) GetSignInRoute() string { return "/login" }
 func (m *MockConfigService) GetRouterEnableTrailingSlash() bool     { return true }
 func (m *MockConfigService) GetRouterEnableSlashRedirect() bool     { return true }
 func (m *MockConfigService) GetRouterEnableMethodNotAllowed() bool  { return true }
+func (m *MockConfigService) GetRouterParameterValidationStatus() int { return 404 }
+func (m *MockConfigService) GetRouterValidationMode() string { return "warn" }
+func (m *MockConfigService) GetRouterBasePath() string { return "" }
+func (m *MockConfigService) GetRouterRenderMode() string { return "direct" }
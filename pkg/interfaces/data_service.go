@@ -4,8 +4,10 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 // DataServiceInfo holds information about required data services
@@ -24,6 +26,14 @@ type RouterContext interface {
 	// URL Parameter access (from Chi router path parameters like /{id})
 	GetURLParam(key string) string
 	GetAllURLParams() map[string]string
+	// GetURLParamSegments returns a catch-all parameter split into its path segments
+	// (e.g. /docs/a/b/c -> ["a", "b", "c"]); empty for an unmatched optional catch-all
+	GetURLParamSegments(key string) []string
+	// Typed URL parameter access; values declared with dynamic.parameters.<name>.type
+	// are converted once per request, other values are parsed on demand
+	GetURLParamInt(key string) (int, error)
+	GetURLParamUUID(key string) (uuid.UUID, error)
+	GetURLParamTime(key string) (time.Time, error)
 
 	// Query Parameter access (from URL query string like ?page=5&size=10)
 	GetQueryParam(key string) string
@@ -33,7 +43,7 @@ type RouterContext interface {
 	// Original request access for advanced scenarios
 	Request() *http.Request
 
-	// Chi-specific context access
+	// Chi-specific context access (nil when the request was routed by another router adapter)
 	ChiContext() *chi.Context
 }
 
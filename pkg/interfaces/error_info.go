@@ -0,0 +1,74 @@
+package interfaces
+
+import "net/http"
+
+// ErrorInfo describes the error an error template renders.
+// Error templates read it from their context via router.GetErrorInfo(ctx).
+type ErrorInfo struct {
+	StatusCode int    `json:"status_code"`
+	MessageKey string `json:"message_key,omitempty"` // i18n key of the message
+	Message    string `json:"message"`               // localized message (from MessageKey when empty)
+	RequestID  string `json:"request_id,omitempty"`
+	Path       string `json:"path"`                // original request path
+	Parameter  string `json:"parameter,omitempty"` // route parameter that failed validation
+	Cause      error  `json:"-"`                   // underlying error, only kept in development
+}
+
+// Message keys of the built-in error messages, translatable in the i18n section of a layout
+const (
+	ErrorMessageKeyBadRequest       = "error_bad_request"
+	ErrorMessageKeyUnauthorized     = "error_unauthorized"
+	ErrorMessageKeyForbidden        = "error_forbidden"
+	ErrorMessageKeyNotFound         = "error_not_found"
+	ErrorMessageKeyMethodNotAllowed = "error_method_not_allowed"
+	ErrorMessageKeyTooManyRequests  = "error_too_many_requests"
+	ErrorMessageKeyInternal         = "error_internal"
+)
+
+// defaultErrorMessages are used when a message key has no translation
+var defaultErrorMessages = map[string]string{
+	ErrorMessageKeyBadRequest:       "The request is invalid.",
+	ErrorMessageKeyUnauthorized:     "Please sign in to view this page.",
+	ErrorMessageKeyForbidden:        "You do not have permission to view this page.",
+	ErrorMessageKeyNotFound:         "The requested page could not be found.",
+	ErrorMessageKeyMethodNotAllowed: "This method is not allowed for the requested page.",
+	ErrorMessageKeyTooManyRequests:  "Too many requests, please try again later.",
+	ErrorMessageKeyInternal:         "Something went wrong on our side.",
+}
+
+// ErrorMessageKeyForStatus returns the key of the built-in message for a status code
+func ErrorMessageKeyForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return ErrorMessageKeyBadRequest
+	case http.StatusUnauthorized:
+		return ErrorMessageKeyUnauthorized
+	case http.StatusForbidden:
+		return ErrorMessageKeyForbidden
+	case http.StatusNotFound:
+		return ErrorMessageKeyNotFound
+	case http.StatusMethodNotAllowed:
+		return ErrorMessageKeyMethodNotAllowed
+	case http.StatusTooManyRequests:
+		return ErrorMessageKeyTooManyRequests
+	default:
+		return ErrorMessageKeyInternal
+	}
+}
+
+// DefaultErrorMessage returns the English message of a built-in message key
+func DefaultErrorMessage(key string) string {
+	if message, ok := defaultErrorMessages[key]; ok {
+		return message
+	}
+	return defaultErrorMessages[ErrorMessageKeyInternal]
+}
+
+// NewErrorInfo creates the error info for a status code with its built-in message key
+func NewErrorInfo(status int, path string) *ErrorInfo {
+	return &ErrorInfo{
+		StatusCode: status,
+		MessageKey: ErrorMessageKeyForStatus(status),
+		Path:       path,
+	}
+}
@@ -0,0 +1,34 @@
+package interfaces
+
+import "time"
+
+// RateLimiter decides if a client may send another request (pluggable)
+type RateLimiter interface {
+	// Allow takes a token from the bucket of key, which holds requests tokens refilled over per.
+	// When the bucket is empty it returns false and the time until the next token is available.
+	Allow(key string, requests int, per time.Duration) (bool, time.Duration)
+}
+
+// RateLimitKey selects whose requests share a bucket
+type RateLimitKey string
+
+const (
+	// RateLimitKeyIP limits each client IP address (default)
+	RateLimitKeyIP RateLimitKey = "ip"
+	// RateLimitKeySession limits each session, clients without session are limited by IP
+	RateLimitKeySession RateLimitKey = "session"
+	// RateLimitKeyRoute limits all clients of a route together
+	RateLimitKeyRoute RateLimitKey = "route"
+)
+
+// DefaultRateLimitPeriod is the period of TR_SECURITY_RATE_LIMIT_REQUESTS and of
+// rate_limit sections without "per"
+const DefaultRateLimitPeriod = time.Minute
+
+// RateLimitSettings contains the "rate_limit" section of a metadata file,
+// e.g. "rate_limit: { requests: 10, per: 1m, key: session }"
+type RateLimitSettings struct {
+	Requests int           `json:"requests"`
+	Per      time.Duration `json:"per"`
+	Key      RateLimitKey  `json:"key,omitempty"`
+}
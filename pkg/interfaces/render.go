@@ -0,0 +1,8 @@
+package interfaces
+
+// Page render modes (TR_ROUTER_RENDER_MODE)
+const (
+	RenderModeDirect    = "direct"    // render straight into the response
+	RenderModeBuffered  = "buffered"  // render into a pooled buffer and send it only on success
+	RenderModeStreaming = "streaming" // render into the response and flush at layout boundaries
+)
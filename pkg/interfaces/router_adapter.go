@@ -0,0 +1,31 @@
+package interfaces
+
+import "net/http"
+
+// RouterAdapter abstracts the HTTP router routes are registered on (chi or net/http ServeMux).
+// Patterns use the router syntax: /user/{id}, /org/$orgId and trailing catch-alls like /docs/{slug...}
+type RouterAdapter interface {
+	http.Handler
+
+	// Handle registers a handler for a pattern; an empty method matches all methods
+	Handle(method, pattern string, handler http.Handler) error
+
+	// NotFound registers the handler for requests without a matching route
+	NotFound(handler http.HandlerFunc) error
+
+	// MethodNotAllowed registers the handler for routes that do not support the request method
+	MethodNotAllowed(handler http.HandlerFunc) error
+
+	// Mount returns an adapter whose routes are served below prefix
+	Mount(prefix string) (RouterAdapter, error)
+
+	// Redispatch serves the request again for path (relative to the mount prefix)
+	// without changing the URL seen by the client
+	Redispatch(w http.ResponseWriter, r *http.Request, path string)
+
+	// URLParam returns a path parameter of the matched route
+	URLParam(r *http.Request, name string) string
+
+	// URLParams returns all non-empty path parameters of the matched route
+	URLParams(r *http.Request) map[string]string
+}
@@ -5,12 +5,12 @@ import (
 	"net/http"
 
 	"github.com/a-h/templ"
-	"github.com/go-chi/chi/v5"
 )
 
+// AssetsService serves static assets
 type AssetsService interface {
-	SetupRoutesWithRouter(mux chi.Router)
-	SetupRoutes(mux *chi.Mux)
+	// SetupRoutes registers the asset routes through the router adapter
+	SetupRoutes(router RouterAdapter) error
 }
 
 // Import central type definitions to eliminate struct redundancy
@@ -33,12 +33,16 @@ type I18nService interface {
 // TemplateService handles template rendering
 type TemplateService interface {
 	RenderComponent(route Route, routerCtx RouterContext, ctx context.Context) (templ.Component, error)
+	// RenderFragment renders a named parameterless component exported by the page package
+	RenderFragment(route Route, fragment string, routerCtx RouterContext, ctx context.Context) (templ.Component, error)
 	RenderLayoutComponent(layoutPath string, content templ.Component, ctx context.Context) (templ.Component, error)
 }
 
 // LayoutService handles layout resolution and wrapping
 type LayoutService interface {
 	FindLayoutForTemplate(templatePath string) *LayoutTemplate
+	// FindNamedLayout finds a layout selected by name in metadata (nil if it does not exist)
+	FindNamedLayout(name string) *LayoutTemplate
 	WrapInLayout(component templ.Component, layout *LayoutTemplate, ctx context.Context) templ.Component
 }
 
@@ -46,18 +50,34 @@ type LayoutService interface {
 type ErrorService interface {
 	FindErrorTemplateForPath(path string) *ErrorTemplate
 	CreateErrorComponent(message, path string) templ.Component
+	// CreateErrorInfoComponent renders the nearest error template for the status of info,
+	// starting the lookup at lookupPath
+	CreateErrorInfoComponent(info *ErrorInfo, lookupPath string) templ.Component
 }
 
 // ValidationService handles unified validation of routes and configurations
 type ValidationService interface {
 	ValidateConfiguration(routes []Route, configs map[string]*ConfigFile) error
+	// Validate returns the structured result without failing on errors
+	Validate(routes []Route, configs map[string]*ConfigFile) *ValidationResult
+	// ValidateRedirects checks redirect and rewrite rules, including redirect loops
+	ValidateRedirects(rules []RedirectRule, routes []Route) *ValidationResult
 }
 
 // SessionStore interface for session management (pluggable)
 type SessionStore interface {
+	// GetSession returns the session of the request cookie and slides its expiry
 	GetSession(req *http.Request) (*Session, error)
-	CreateSession(userID string) (*Session, error)
+	// CreateSession creates a session, remember selects the longer "remember me" expiry
+	CreateSession(userID string, remember bool) (*Session, error)
+	// RotateSession moves a session to a new ID, e.g. after a privilege change, to prevent fixation
+	RotateSession(sessionID string) (*Session, error)
 	DeleteSession(sessionID string) error
+
+	// WriteSessionCookie sets the session cookie with the configured security attributes and expiry
+	WriteSessionCookie(w http.ResponseWriter, session *Session)
+	// ClearSessionCookie removes the session cookie
+	ClearSessionCookie(w http.ResponseWriter)
 }
 
 // UserEntity defines the minimal interface that any user implementation must satisfy
@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	"net/http"
+
 	"github.com/a-h/templ"
 )
 
@@ -23,6 +25,18 @@ type TemplateRegistry interface {
 	GetRouteToTemplateMapping() map[string]string
 	GetTemplateByRoute(route string) (templ.Component, error)
 
+	// GetComponentTemplateKey returns the template key of a component declared in a page
+	// package that is not a page, layout or error template, looked up by shared.PackageComponentKey
+	GetComponentTemplateKey(componentKey string) (string, bool)
+
+	// GetAction returns the Action function of a page package, looked up by shared.PageActionKey
+	GetAction(actionKey string) (ActionFunc, bool)
+
+	// Route handlers of route.go files: route pattern to handler key, and the handler
+	// looked up by shared.RouteHandlerKey
+	GetRouteHandlerMapping() map[string]string
+	GetRouteHandler(handlerKey string) (http.Handler, bool)
+
 	// Data Service Integration
 	RequiresDataService(key string) bool
 	GetDataServiceInfo(key string) (DataServiceInfo, bool)
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/a-h/templ"
@@ -91,6 +92,26 @@ func (m *MockTemplateRegistry) SetShouldError(shouldError bool) {
 	m.shouldError = shouldError
 }
 
+// GetComponentTemplateKey retrieves the template key of a page package component
+func (m *MockTemplateRegistry) GetComponentTemplateKey(componentKey string) (string, bool) {
+	return "", false
+}
+
+// GetAction retrieves the Action function of a page package
+func (m *MockTemplateRegistry) GetAction(actionKey string) (ActionFunc, bool) {
+	return nil, false
+}
+
+// GetRouteHandlerMapping returns the route-to-handler mapping
+func (m *MockTemplateRegistry) GetRouteHandlerMapping() map[string]string {
+	return map[string]string{}
+}
+
+// GetRouteHandler retrieves the Handler of a route.go file
+func (m *MockTemplateRegistry) GetRouteHandler(handlerKey string) (http.Handler, bool) {
+	return nil, false
+}
+
 // RequiresDataService checks if a template requires a data service
 func (m *MockTemplateRegistry) RequiresDataService(key string) bool {
 	// For testing, return false by default
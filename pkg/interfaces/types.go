@@ -1,6 +1,9 @@
 package interfaces
 
-import "time"
+import (
+	"net/http"
+	"time"
+)
 
 // CENTRAL TYPE DEFINITIONS - Consolidation of all duplicate structs
 // This file eliminates the massive struct redundancy identified in code quality analysis
@@ -28,6 +31,12 @@ type Route struct {
 	RequiresDataService  bool   `json:"requires_data_service,omitempty"`
 	DataServiceInterface string `json:"data_service_interface,omitempty"`
 	DataParameterType    string `json:"data_parameter_type,omitempty"`
+
+	// Form actions: the page package exports an Action function for POST, PUT and DELETE
+	HasAction bool `json:"has_action,omitempty"`
+
+	// Route handlers: TemplateFile is a route.go file whose Handler answers every request method
+	IsHandler bool `json:"is_handler,omitempty"`
 }
 
 // LayoutTemplate represents a layout template
@@ -38,6 +47,33 @@ type LayoutTemplate struct {
 	ComponentName string `json:"component_name,omitempty"`
 	Content       string `json:"content,omitempty"`
 	LayoutLevel   int    `json:"layout_level,omitempty"`
+
+	// Parent is the enclosing layout of a parent directory (nil for the outermost layout)
+	Parent *LayoutTemplate `json:"-"`
+}
+
+// Chain returns the layout followed by its enclosing layouts, innermost first
+func (l *LayoutTemplate) Chain() []*LayoutTemplate {
+	var chain []*LayoutTemplate
+	for layout := l; layout != nil; layout = layout.Parent {
+		chain = append(chain, layout)
+	}
+	return chain
+}
+
+// LayoutNone disables the layout of a page ("layout: none")
+const LayoutNone = "none"
+
+// LayoutSettings contains the "layout" section of a page or layout metadata file
+type LayoutSettings struct {
+	// Inherit nests the layout inside the layouts of its parent directories (default: true)
+	Inherit bool `json:"inherit"`
+
+	// None renders the page without any layout
+	None bool `json:"none,omitempty"`
+
+	// Name selects a layout by its directory below the layout root instead of the nearest layout
+	Name string `json:"name,omitempty"`
 }
 
 // ErrorTemplate represents an error template
@@ -86,6 +122,8 @@ type AuthResult struct {
 	User            UserEntity `json:"user,omitempty"`
 	RedirectURL     string     `json:"redirect_url,omitempty"`
 	ErrorMessage    string     `json:"error_message,omitempty"`
+	// Session is the session the user was authenticated with, its cookie must be written again when Renewed
+	Session *Session `json:"-"`
 }
 
 // Session represents a user session
@@ -95,6 +133,10 @@ type Session struct {
 	Valid     bool      `json:"valid"`
 	CreatedAt time.Time `json:"created_at"`
 	ExpiresAt time.Time `json:"expires_at"`
+	// Remember uses the longer "remember me" expiry
+	Remember bool `json:"remember,omitempty"`
+	// Renewed is set by GetSession when it extended ExpiresAt, the cookie must be written again
+	Renewed bool `json:"-"`
 }
 
 // Template represents a *.templ file containing UI components
@@ -125,12 +167,70 @@ type ConfigFile struct {
 	MultiLocaleI18n map[string]map[string]string `json:"multi_locale_i18n,omitempty"`
 
 	// Settings
-	AuthSettings    *AuthSettings    `json:"auth_settings,omitempty"`
-	LayoutSettings  interface{}      `json:"layout_settings,omitempty"`
-	ErrorSettings   interface{}      `json:"error_settings,omitempty"`
-	DynamicSettings *DynamicSettings `json:"dynamic_settings,omitempty"`
+	AuthSettings      *AuthSettings      `json:"auth_settings,omitempty"`
+	LayoutSettings    interface{}        `json:"layout_settings,omitempty"`
+	ErrorSettings     interface{}        `json:"error_settings,omitempty"`
+	DynamicSettings   *DynamicSettings   `json:"dynamic_settings,omitempty"`
+	APISettings       *APISettings       `json:"api_settings,omitempty"`
+	RateLimitSettings *RateLimitSettings `json:"rate_limit_settings,omitempty"`
+	SecuritySettings  *SecuritySettings  `json:"security_settings,omitempty"`
+
+	// Redirects and rewrites declared next to the template
+	Redirects []RedirectRule `json:"redirects,omitempty"`
+}
+
+// RedirectRule maps an old URL pattern to a new one
+// e.g., from "/blog/{slug}" to "/{locale}/news/{slug}"; {locale} is filled from the request locale
+type RedirectRule struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Status is one of 301, 302, 307 or 308 (defaults to DefaultRedirectStatus)
+	Status int `json:"status,omitempty"`
+	// Rewrite serves the target route without changing the URL
+	Rewrite bool `json:"rewrite,omitempty"`
+	// Source is the file the rule was declared in
+	Source string `json:"source,omitempty"`
+}
+
+// DefaultRedirectStatus is used for redirect rules without an explicit status
+const DefaultRedirectStatus = http.StatusMovedPermanently
+
+// IsValidRedirectStatus checks if a redirect status is supported (zero means default)
+func IsValidRedirectStatus(status int) bool {
+	switch status {
+	case 0, http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	}
+	return false
 }
 
+// APISettings contains the "api" section of a page metadata file
+type APISettings struct {
+	// JSON serves the DataService result as JSON to requests asking for it
+	JSON bool `json:"json"`
+}
+
+// SecuritySettings contains the "security" section of a metadata file
+type SecuritySettings struct {
+	// ContentSecurityPolicy replaces the configured policy for the route, {nonce} is replaced
+	// with the nonce of the request
+	ContentSecurityPolicy string `json:"content_security_policy,omitempty"`
+	// FrameOptions replaces the X-Frame-Options header of the route, DENY when empty
+	FrameOptions FrameOptions `json:"frame_options,omitempty"`
+}
+
+// FrameOptions controls whether a page may be framed by other pages
+type FrameOptions string
+
+const (
+	// FrameOptionsDeny forbids framing (default)
+	FrameOptionsDeny FrameOptions = "DENY"
+	// FrameOptionsSameOrigin allows framing by pages of the same origin
+	FrameOptionsSameOrigin FrameOptions = "SAMEORIGIN"
+	// FrameOptionsNone omits the header, e.g. for embeds restricted by CSP frame-ancestors
+	FrameOptionsNone FrameOptions = "NONE"
+)
+
 // DynamicSettings contains configuration for dynamic route parameters
 type DynamicSettings struct {
 	Parameters map[string]*DynamicParameterConfig `json:"parameters,omitempty"`
@@ -141,6 +241,31 @@ type DynamicParameterConfig struct {
 	Validation      string   `json:"validation,omitempty"`
 	Description     string   `json:"description,omitempty"`
 	SupportedValues []string `json:"supported_values,omitempty"`
+	// Type converts the parameter value once per request (int, uuid, date, enum)
+	Type string `json:"type,omitempty"`
+	// Format is the time layout for date parameters (defaults to DefaultDateParameterFormat)
+	Format string `json:"format,omitempty"`
+}
+
+// Dynamic parameter types supported in .templ.yaml
+const (
+	ParameterTypeString = "string"
+	ParameterTypeInt    = "int"
+	ParameterTypeUUID   = "uuid"
+	ParameterTypeDate   = "date"
+	ParameterTypeEnum   = "enum"
+)
+
+// DefaultDateParameterFormat is the layout used for date parameters without an explicit format
+const DefaultDateParameterFormat = "2006-01-02"
+
+// IsValidParameterType checks if a parameter type is supported (empty means string)
+func IsValidParameterType(paramType string) bool {
+	switch paramType {
+	case "", ParameterTypeString, ParameterTypeInt, ParameterTypeUUID, ParameterTypeDate, ParameterTypeEnum:
+		return true
+	}
+	return false
 }
 
 // InternationalizationIdentifier represents a structured key for translations
@@ -0,0 +1,50 @@
+// Package adapter connects the router to concrete HTTP routers (chi and net/http ServeMux)
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+)
+
+// defaultAdapter reads path values of requests that were not routed through an adapter
+var defaultAdapter interfaces.RouterAdapter = unroutedAdapter{}
+
+// FromRequest returns the adapter that routed the request.
+// Requests routed outside an adapter are read with chi semantics.
+func FromRequest(r *http.Request) interfaces.RouterAdapter {
+	if r != nil {
+		if routerAdapter, ok := r.Context().Value(shared.RouterAdapterKey).(interfaces.RouterAdapter); ok {
+			return routerAdapter
+		}
+	}
+	return defaultAdapter
+}
+
+// withRequestContext exposes the adapter and its mount prefix to the handler
+func withRequestContext(routerAdapter interfaces.RouterAdapter, basePath string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), shared.RouterAdapterKey, routerAdapter)
+		if basePath != "" {
+			ctx = shared.WithBasePath(ctx, basePath)
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// register runs a registration that may panic (both chi and ServeMux panic on invalid
+// or conflicting patterns) and reports the panic as a route error
+func register(pattern string, registration func()) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = shared.NewRouteError("failed to register route", fmt.Sprint(recovered)).
+				WithContext("pattern", pattern)
+		}
+	}()
+
+	registration()
+	return nil
+}
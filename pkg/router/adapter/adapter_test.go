@@ -0,0 +1,193 @@
+package adapter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var adapterFactories = map[string]func() interfaces.RouterAdapter{
+	"chi":      func() interfaces.RouterAdapter { return NewChiAdapter(chi.NewRouter()) },
+	"servemux": func() interfaces.RouterAdapter { return NewServeMuxAdapter(http.NewServeMux()) },
+}
+
+func serve(router http.Handler, method, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec
+}
+
+// paramsHandler writes the URL params seen through the adapter of the request
+func paramsHandler(names ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		routerAdapter := FromRequest(r)
+		body := ""
+		for _, name := range names {
+			body += name + "=" + routerAdapter.URLParam(r, name) + ";"
+		}
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func TestAdapterHandle(t *testing.T) {
+	tests := []struct {
+		name         string
+		pattern      string
+		params       []string
+		requestPath  string
+		expectedCode int
+		expectedBody string
+	}{
+		{name: "Root", pattern: "/", requestPath: "/", expectedCode: http.StatusOK},
+		{name: "Root does not match subtree", pattern: "/", requestPath: "/other", expectedCode: http.StatusNotFound},
+		{name: "Named param", pattern: "/user/{id}", params: []string{"id"}, requestPath: "/user/42", expectedCode: http.StatusOK, expectedBody: "id=42;"},
+		{name: "Dollar param", pattern: "/org/$orgId", params: []string{"orgId"}, requestPath: "/org/acme", expectedCode: http.StatusOK, expectedBody: "orgId=acme;"},
+		{name: "Catch-all", pattern: "/docs/{slug...}", params: []string{"slug"}, requestPath: "/docs/a/b", expectedCode: http.StatusOK, expectedBody: "slug=a/b;"},
+		{name: "Regexp constraint", pattern: "/item/{id:[0-9]+}", params: []string{"id"}, requestPath: "/item/42", expectedCode: http.StatusOK, expectedBody: "id=42;"},
+		{name: "Regexp constraint mismatch", pattern: "/item/{id:[0-9]+}", params: []string{"id"}, requestPath: "/item/abc", expectedCode: http.StatusNotFound},
+		{name: "Regexp constraint is anchored", pattern: "/item/{id:[0-9]+}", params: []string{"id"}, requestPath: "/item/42abc", expectedCode: http.StatusNotFound},
+	}
+
+	for adapterName, newAdapter := range adapterFactories {
+		for _, tt := range tests {
+			t.Run(adapterName+"/"+tt.name, func(t *testing.T) {
+				router := newAdapter()
+				require.NoError(t, router.Handle(http.MethodGet, tt.pattern, paramsHandler(tt.params...)))
+
+				rec := serve(router, http.MethodGet, tt.requestPath)
+				assert.Equal(t, tt.expectedCode, rec.Code)
+				if tt.expectedCode == http.StatusOK {
+					assert.Equal(t, tt.expectedBody, rec.Body.String())
+				}
+			})
+		}
+	}
+}
+
+func TestAdapterURLParams(t *testing.T) {
+	for adapterName, newAdapter := range adapterFactories {
+		t.Run(adapterName, func(t *testing.T) {
+			router := newAdapter()
+			var params map[string]string
+			err := router.Handle(http.MethodGet, "/{locale}/org/$orgId/docs/{path...}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				params = FromRequest(r).URLParams(r)
+			}))
+			require.NoError(t, err)
+
+			serve(router, http.MethodGet, "/en/org/acme/docs/guide/intro")
+			// chi additionally exposes its raw wildcard
+			delete(params, "*")
+			assert.Equal(t, map[string]string{"locale": "en", "orgId": "acme", "path": "guide/intro"}, params)
+		})
+	}
+}
+
+func TestAdapterRejectsInvalidPatterns(t *testing.T) {
+	for adapterName, newAdapter := range adapterFactories {
+		t.Run(adapterName, func(t *testing.T) {
+			router := newAdapter()
+			assert.Error(t, router.Handle(http.MethodGet, "/docs/{slug...}/edit", http.NotFoundHandler()))
+			assert.Error(t, router.Handle(http.MethodGet, "/item/{id:[0-9+}", http.NotFoundHandler()))
+		})
+	}
+}
+
+func TestServeMuxAdapterReportsConflicts(t *testing.T) {
+	router := NewServeMuxAdapter(http.NewServeMux())
+
+	require.NoError(t, router.Handle(http.MethodGet, "/user/{id}", http.NotFoundHandler()))
+	assert.Error(t, router.Handle(http.MethodGet, "/user/{name}", http.NotFoundHandler()), "conflicting pattern must not panic")
+
+	// The fallback subtree conflicts with a "/" pattern of the application
+	mux := http.NewServeMux()
+	mux.Handle("/", http.NotFoundHandler())
+	router = NewServeMuxAdapter(mux)
+	assert.Error(t, router.NotFound(http.NotFound), "conflicting fallback must not panic")
+}
+
+func TestAdapterMount(t *testing.T) {
+	for adapterName, newAdapter := range adapterFactories {
+		t.Run(adapterName, func(t *testing.T) {
+			root := newAdapter()
+			mounted, err := root.Mount("/portal/")
+			require.NoError(t, err)
+
+			require.NoError(t, mounted.Handle(http.MethodGet, "/user/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(shared.GetBasePath(r.Context()) + ":" + FromRequest(r).URLParam(r, "id")))
+			})))
+
+			rec := serve(root, http.MethodGet, "/portal/user/7")
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "/portal:7", rec.Body.String())
+
+			assert.Equal(t, http.StatusNotFound, serve(root, http.MethodGet, "/user/7").Code)
+		})
+	}
+}
+
+func TestAdapterFallbackHandlers(t *testing.T) {
+	for adapterName, newAdapter := range adapterFactories {
+		t.Run(adapterName, func(t *testing.T) {
+			root := newAdapter()
+			router, err := root.Mount("/app")
+			require.NoError(t, err)
+
+			require.NoError(t, router.Handle(http.MethodPost, "/form", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			})))
+			require.NoError(t, router.NotFound(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+				_, _ = w.Write([]byte(shared.GetBasePath(r.Context())))
+			}))
+			require.NoError(t, router.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}))
+
+			assert.Equal(t, http.StatusCreated, serve(root, http.MethodPost, "/app/form").Code)
+			assert.Equal(t, http.StatusMethodNotAllowed, serve(root, http.MethodGet, "/app/form").Code)
+
+			rec := serve(root, http.MethodGet, "/app/missing")
+			assert.Equal(t, http.StatusTeapot, rec.Code)
+			assert.Equal(t, "/app", rec.Body.String())
+		})
+	}
+}
+
+func TestAdapterRedispatch(t *testing.T) {
+	for adapterName, newAdapter := range adapterFactories {
+		t.Run(adapterName, func(t *testing.T) {
+			root := newAdapter()
+			router, err := root.Mount("/portal")
+			require.NoError(t, err)
+
+			require.NoError(t, router.Handle(http.MethodGet, "/user/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(r.URL.Path + ":" + FromRequest(r).URLParam(r, "id")))
+			})))
+			require.NoError(t, router.Handle(http.MethodGet, "/me", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				router.Redispatch(w, r, "/user/1")
+			})))
+
+			rec := serve(root, http.MethodGet, "/portal/me")
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "/portal/user/1:1", rec.Body.String())
+		})
+	}
+}
+
+func TestFromRequestDefaultsToChi(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	assert.Equal(t, defaultAdapter, FromRequest(r))
+	assert.Empty(t, FromRequest(r).URLParams(r))
+
+	// Without a router there is nothing to register or redispatch to
+	assert.Error(t, FromRequest(r).Handle(http.MethodGet, "/", http.NotFoundHandler()))
+	rec := httptest.NewRecorder()
+	FromRequest(r).Redispatch(rec, r, "/user/1")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
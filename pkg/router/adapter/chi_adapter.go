@@ -0,0 +1,130 @@
+package adapter
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/go-chi/chi/v5"
+)
+
+// chiAdapter registers routes on a chi router (private implementation)
+type chiAdapter struct {
+	router   chi.Router
+	basePath string
+}
+
+// NewChiAdapter creates a router adapter for a chi router
+func NewChiAdapter(router chi.Router) interfaces.RouterAdapter {
+	return &chiAdapter{router: router}
+}
+
+// ServeHTTP implements http.Handler
+func (a *chiAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.router.ServeHTTP(w, r)
+}
+
+// Handle registers a handler; catch-all segments are mounted on the chi wildcard
+// and exposed under their parameter name
+func (a *chiAdapter) Handle(method, pattern string, handler http.Handler) error {
+	if shared.HasNonTrailingCatchAll(pattern) {
+		return shared.NewRouteError("failed to register route", "catch-all segment must be the last segment").
+			WithContext("pattern", pattern)
+	}
+
+	chiPattern := shared.ToChiRoutePattern(pattern)
+	handler = withRequestContext(a, a.basePath, handler)
+	if _, paramName, _, ok := shared.SplitCatchAllPattern(pattern); ok {
+		handler = namedCatchAllHandler(paramName, handler)
+	}
+
+	return register(chiPattern, func() {
+		if method == "" {
+			a.router.Handle(chiPattern, handler)
+			return
+		}
+		a.router.Method(method, chiPattern, handler)
+	})
+}
+
+// NotFound implements interfaces.RouterAdapter
+func (a *chiAdapter) NotFound(handler http.HandlerFunc) error {
+	a.router.NotFound(withRequestContext(a, a.basePath, handler).ServeHTTP)
+	return nil
+}
+
+// MethodNotAllowed implements interfaces.RouterAdapter
+func (a *chiAdapter) MethodNotAllowed(handler http.HandlerFunc) error {
+	a.router.MethodNotAllowed(withRequestContext(a, a.basePath, handler).ServeHTTP)
+	return nil
+}
+
+// Mount creates a chi sub-router mounted below prefix
+func (a *chiAdapter) Mount(prefix string) (interfaces.RouterAdapter, error) {
+	prefix = shared.NormalizeBasePath(prefix)
+	if prefix == "" {
+		return a, nil
+	}
+
+	subRouter := chi.NewRouter()
+	if err := register(prefix, func() { a.router.Mount(prefix, subRouter) }); err != nil {
+		return nil, err
+	}
+
+	return &chiAdapter{router: subRouter, basePath: a.basePath + prefix}, nil
+}
+
+// Redispatch routes the request on path with a fresh chi routing context,
+// while the URL keeps the mount prefix
+func (a *chiAdapter) Redispatch(w http.ResponseWriter, r *http.Request, path string) {
+	routeCtx := chi.NewRouteContext()
+	routeCtx.RoutePath = path
+
+	redispatched := r.Clone(context.WithValue(r.Context(), chi.RouteCtxKey, routeCtx))
+	redispatched.URL.Path = shared.JoinBasePath(a.basePath, path)
+	redispatched.URL.RawPath = ""
+
+	a.router.ServeHTTP(w, redispatched)
+}
+
+// URLParam implements interfaces.RouterAdapter
+func (a *chiAdapter) URLParam(r *http.Request, name string) string {
+	return chi.URLParam(r, name)
+}
+
+// URLParams implements interfaces.RouterAdapter
+func (a *chiAdapter) URLParams(r *http.Request) map[string]string {
+	return chiURLParams(r)
+}
+
+// chiURLParams returns the non-empty path parameters of the chi routing context
+func chiURLParams(r *http.Request) map[string]string {
+	params := make(map[string]string)
+
+	chiCtx := chi.RouteContext(r.Context())
+	if chiCtx == nil {
+		return params
+	}
+
+	for i, key := range chiCtx.URLParams.Keys {
+		if i < len(chiCtx.URLParams.Values) {
+			value := chiCtx.URLParams.Values[i]
+			if value != "" {
+				params[key] = value
+			}
+		}
+	}
+	return params
+}
+
+// namedCatchAllHandler copies the chi wildcard value into a named URL parameter
+func namedCatchAllHandler(paramName string, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if chiCtx := chi.RouteContext(r.Context()); chiCtx != nil {
+			chiCtx.URLParams.Add(paramName, strings.Trim(chiCtx.URLParam("*"), "/"))
+		}
+		next.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,245 @@
+package adapter
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+)
+
+// probeMethods are checked to tell "not found" from "method not allowed" on the fallback route
+var probeMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// serveMuxAdapter registers routes on a Go 1.22+ http.ServeMux (private implementation).
+// Mounted adapters share the mux and register their patterns with the mount prefix.
+type serveMuxAdapter struct {
+	mux      *http.ServeMux
+	basePath string
+
+	// Fallback handlers, served by a subtree pattern below the mount prefix
+	notFound         http.HandlerFunc
+	methodNotAllowed http.HandlerFunc
+	fallbackPattern  string
+}
+
+// NewServeMuxAdapter creates a router adapter for a net/http ServeMux
+func NewServeMuxAdapter(mux *http.ServeMux) interfaces.RouterAdapter {
+	return &serveMuxAdapter{mux: mux}
+}
+
+// ServeHTTP implements http.Handler
+func (a *serveMuxAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mux.ServeHTTP(w, r)
+}
+
+// Handle registers a handler with a "METHOD /pattern" ServeMux pattern
+func (a *serveMuxAdapter) Handle(method, pattern string, handler http.Handler) error {
+	if shared.HasNonTrailingCatchAll(pattern) {
+		return shared.NewRouteError("failed to register route", "catch-all segment must be the last segment").
+			WithContext("pattern", pattern)
+	}
+
+	constraints, err := a.paramConstraints(pattern)
+	if err != nil {
+		return err
+	}
+	if len(constraints) > 0 {
+		handler = a.constrainedHandler(constraints, handler)
+	}
+
+	muxPattern := a.toServeMuxPattern(pattern)
+	if method != "" {
+		muxPattern = method + " " + muxPattern
+	}
+
+	return register(muxPattern, func() {
+		a.mux.Handle(muxPattern, withRequestContext(a, a.basePath, handler))
+	})
+}
+
+// paramConstraints compiles the chi regexp constraints of pattern, e.g. {id:[0-9]+},
+// keyed by parameter name. ServeMux wildcards match any segment, so the constraints are
+// checked by the handler instead.
+func (a *serveMuxAdapter) paramConstraints(pattern string) (map[string]*regexp.Regexp, error) {
+	constraints := make(map[string]*regexp.Regexp)
+	for _, segment := range strings.Split(pattern, "/") {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+			continue
+		}
+		name, expr, ok := strings.Cut(segment[1:len(segment)-1], ":")
+		if !ok || expr == "" {
+			continue
+		}
+
+		// Anchor like chi does, so the whole segment has to match
+		if !strings.HasPrefix(expr, "^") {
+			expr = "^" + expr
+		}
+		if !strings.HasSuffix(expr, "$") {
+			expr += "$"
+		}
+
+		compiled, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, shared.NewRouteError("failed to register route", "invalid parameter constraint").
+				WithContext("pattern", pattern).
+				WithContext("param", name).
+				WithCause(err)
+		}
+		constraints[name] = compiled
+	}
+	return constraints, nil
+}
+
+// constrainedHandler serves the not found handler when a path value does not match its constraint,
+// like chi does for a segment that fails its regexp
+func (a *serveMuxAdapter) constrainedHandler(constraints map[string]*regexp.Regexp, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for name, constraint := range constraints {
+			if !constraint.MatchString(r.PathValue(name)) {
+				if a.notFound != nil {
+					a.notFound(w, r)
+					return
+				}
+				http.NotFound(w, r)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// NotFound implements interfaces.RouterAdapter
+func (a *serveMuxAdapter) NotFound(handler http.HandlerFunc) error {
+	a.notFound = handler
+	return a.registerFallback()
+}
+
+// MethodNotAllowed implements interfaces.RouterAdapter
+func (a *serveMuxAdapter) MethodNotAllowed(handler http.HandlerFunc) error {
+	a.methodNotAllowed = handler
+	return a.registerFallback()
+}
+
+// registerFallback registers the subtree pattern that serves unmatched requests once.
+// ServeMux prefers every other pattern over it, so it only sees requests without a route.
+// The pattern conflicts with a "/" subtree registered on the mux by the application.
+func (a *serveMuxAdapter) registerFallback() error {
+	if a.fallbackPattern != "" {
+		return nil
+	}
+	fallbackPattern := a.basePath + "/"
+
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.methodNotAllowed != nil && a.allowsOtherMethod(r) {
+			a.methodNotAllowed(w, r)
+			return
+		}
+		if a.notFound != nil {
+			a.notFound(w, r)
+			return
+		}
+		http.NotFound(w, r)
+	})
+
+	if err := register(fallbackPattern, func() {
+		a.mux.Handle(fallbackPattern, withRequestContext(a, a.basePath, fallback))
+	}); err != nil {
+		return err
+	}
+
+	a.fallbackPattern = fallbackPattern
+	return nil
+}
+
+// allowsOtherMethod checks if a route below the mount prefix matches the path for another method
+func (a *serveMuxAdapter) allowsOtherMethod(r *http.Request) bool {
+	for _, method := range probeMethods {
+		if method == r.Method {
+			continue
+		}
+
+		probe := r.Clone(r.Context())
+		probe.Method = method
+		if _, pattern := a.mux.Handler(probe); pattern != "" && pattern != a.fallbackPattern {
+			return true
+		}
+	}
+	return false
+}
+
+// Mount returns an adapter that registers its patterns below prefix on the same mux
+func (a *serveMuxAdapter) Mount(prefix string) (interfaces.RouterAdapter, error) {
+	prefix = shared.NormalizeBasePath(prefix)
+	if prefix == "" {
+		return a, nil
+	}
+	return &serveMuxAdapter{mux: a.mux, basePath: a.basePath + prefix}, nil
+}
+
+// Redispatch serves the request again for path below the mount prefix
+func (a *serveMuxAdapter) Redispatch(w http.ResponseWriter, r *http.Request, path string) {
+	redispatched := r.Clone(r.Context())
+	redispatched.URL.Path = shared.JoinBasePath(a.basePath, path)
+	redispatched.URL.RawPath = ""
+
+	a.mux.ServeHTTP(w, redispatched)
+}
+
+// URLParam implements interfaces.RouterAdapter
+func (a *serveMuxAdapter) URLParam(r *http.Request, name string) string {
+	return r.PathValue(name)
+}
+
+// URLParams returns the wildcards of the matched ServeMux pattern
+func (a *serveMuxAdapter) URLParams(r *http.Request) map[string]string {
+	params := make(map[string]string)
+
+	// Strip the method from "GET /user/{id}"
+	pattern := r.Pattern
+	if idx := strings.Index(pattern, " "); idx >= 0 {
+		pattern = pattern[idx+1:]
+	}
+
+	for _, segment := range strings.Split(pattern, "/") {
+		name, ok := shared.ParseRouteParamSegment(segment)
+		if !ok || name == "$" {
+			continue
+		}
+		if value := r.PathValue(name); value != "" {
+			params[name] = value
+		}
+	}
+	return params
+}
+
+// toServeMuxPattern converts a router pattern to ServeMux syntax
+// e.g., /org/$orgId -> /org/{orgId}, /docs/{slug...?} -> /docs/{slug...}, / -> /{$}
+func (a *serveMuxAdapter) toServeMuxPattern(pattern string) string {
+	segments := strings.Split(a.basePath+pattern, "/")
+	for i, segment := range segments {
+		if name, _, ok := shared.ParseCatchAllSegment(segment); ok {
+			segments[i] = "{" + name + "...}"
+			continue
+		}
+		// Drops $ prefixes and chi regexp constraints; constraints are checked by constrainedHandler
+		if name, ok := shared.ParseRouteParamSegment(segment); ok {
+			segments[i] = "{" + name + "}"
+		}
+	}
+
+	muxPattern := strings.Join(segments, "/")
+	if strings.HasSuffix(muxPattern, "/") {
+		// A trailing slash would match the whole subtree
+		muxPattern += "{$}"
+	}
+	return muxPattern
+}
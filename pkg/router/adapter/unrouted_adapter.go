@@ -0,0 +1,55 @@
+package adapter
+
+import (
+	"net/http"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/go-chi/chi/v5"
+)
+
+// unroutedAdapter reads chi path values of requests that were not routed through an adapter.
+// It has no router, so it cannot register routes and answers redispatched requests with 404.
+type unroutedAdapter struct{}
+
+// ServeHTTP implements http.Handler
+func (a unroutedAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	http.NotFound(w, r)
+}
+
+// Handle implements interfaces.RouterAdapter
+func (a unroutedAdapter) Handle(method, pattern string, handler http.Handler) error {
+	return shared.NewRouteError("failed to register route", "request was not routed through a router adapter").
+		WithContext("pattern", pattern)
+}
+
+// NotFound implements interfaces.RouterAdapter
+func (a unroutedAdapter) NotFound(handler http.HandlerFunc) error {
+	return shared.NewRouteError("failed to register not found handler", "request was not routed through a router adapter")
+}
+
+// MethodNotAllowed implements interfaces.RouterAdapter
+func (a unroutedAdapter) MethodNotAllowed(handler http.HandlerFunc) error {
+	return shared.NewRouteError("failed to register method not allowed handler", "request was not routed through a router adapter")
+}
+
+// Mount implements interfaces.RouterAdapter
+func (a unroutedAdapter) Mount(prefix string) (interfaces.RouterAdapter, error) {
+	return nil, shared.NewRouteError("failed to mount router", "request was not routed through a router adapter").
+		WithContext("prefix", prefix)
+}
+
+// Redispatch answers with 404, there are no routes to serve path
+func (a unroutedAdapter) Redispatch(w http.ResponseWriter, r *http.Request, path string) {
+	http.NotFound(w, r)
+}
+
+// URLParam implements interfaces.RouterAdapter
+func (a unroutedAdapter) URLParam(r *http.Request, name string) string {
+	return chi.URLParam(r, name)
+}
+
+// URLParams implements interfaces.RouterAdapter
+func (a unroutedAdapter) URLParams(r *http.Request) map[string]string {
+	return chiURLParams(r)
+}
@@ -5,7 +5,9 @@ import (
 	"net/http"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/adapter"
 	"github.com/denkhaus/templ-router/pkg/router/pipeline"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/go-chi/chi/v5"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
@@ -30,13 +32,18 @@ type cleanRouterCore struct {
 	handlerPipeline *pipeline.HandlerPipeline
 
 	// Route discovery and processing
-	routeDiscovery RouteDiscovery
-	configLoader   ConfigLoader
+	routeDiscovery    RouteDiscovery
+	configLoader      ConfigLoader
+	validationService interfaces.ValidationService
 
 	// Data storage (clean, no business logic)
 	routes          []interfaces.Route
 	layoutTemplates []LayoutTemplate
 	errorTemplates  []ErrorTemplate
+	redirects       []interfaces.RedirectRule
+
+	// Result of the last startup validation (nil when validation is off)
+	validationResult *interfaces.ValidationResult
 }
 
 // NewCleanRouterCore creates a new clean router with separated concerns for DI
@@ -49,6 +56,7 @@ func NewCleanRouterCore(i do.Injector) (RouterCore, error) {
 	assetsService := do.MustInvoke[interfaces.AssetsService](i)
 	authHandlers := do.MustInvoke[interfaces.AuthHandlers](i)
 	configLoader := do.MustInvoke[ConfigLoader](i)
+	validationService := do.MustInvoke[interfaces.ValidationService](i)
 
 	// Create separated components
 	handlerBuilder, err := NewHandlerBuilder(i)
@@ -67,17 +75,18 @@ func NewCleanRouterCore(i do.Injector) (RouterCore, error) {
 	}
 
 	return &cleanRouterCore{
-		scanPath:        config.GetLayoutRootDirectory(),
-		config:          config,
-		authHandlers:    authHandlers,
-		assetsService:   assetsService,
-		logger:          logger,
-		injector:        i, // Store injector for RouteRegistrar creation
-		handlerBuilder:  handlerBuilder,
-		middlewareSetup: middlewareSetup,
-		handlerPipeline: handlerPipeline,
-		routeDiscovery:  routeDiscovery,
-		configLoader:    configLoader,
+		scanPath:          config.GetLayoutRootDirectory(),
+		config:            config,
+		authHandlers:      authHandlers,
+		assetsService:     assetsService,
+		logger:            logger,
+		injector:          i, // Store injector for RouteRegistrar creation
+		handlerBuilder:    handlerBuilder,
+		middlewareSetup:   middlewareSetup,
+		handlerPipeline:   handlerPipeline,
+		routeDiscovery:    routeDiscovery,
+		configLoader:      configLoader,
+		validationService: validationService,
 	}, nil
 }
 
@@ -92,6 +101,15 @@ func (crc *cleanRouterCore) Initialize() error {
 	}
 	crc.routes = routes
 
+	// Load route configurations and collect declarative redirects
+	configs, loadErrors := crc.loadRouteConfigs()
+	crc.redirects = crc.collectRedirects(configs)
+
+	// Validate routes and their configurations before anything is served
+	if err := crc.validateRoutes(configs, loadErrors); err != nil {
+		return err
+	}
+
 	// Load translations for all discovered templates
 	if err := crc.loadTranslationsForDiscoveredRoutes(); err != nil {
 		crc.logger.Warn("Failed to load some translations during initialization", zap.Error(err))
@@ -115,25 +133,46 @@ func (crc *cleanRouterCore) Initialize() error {
 	crc.logger.Info("Clean router core initialized successfully",
 		zap.Int("routes", len(crc.routes)),
 		zap.Int("layouts", len(crc.layoutTemplates)),
-		zap.Int("error_templates", len(crc.errorTemplates)))
+		zap.Int("error_templates", len(crc.errorTemplates)),
+		zap.Int("redirects", len(crc.redirects)))
 
 	return nil
 }
 
 // RegisterRoutes registers all discovered routes with a Chi router
-func (crc *cleanRouterCore) RegisterRoutes(chiRouter *chi.Mux) error {
-	crc.logger.Info("Registering routes with Chi router")
+func (crc *cleanRouterCore) RegisterRoutes(chiRouter chi.Router) error {
+	return crc.RegisterRoutesWithAdapter(adapter.NewChiAdapter(chiRouter))
+}
+
+// RegisterRoutesWithAdapter registers all discovered routes through a router adapter (chi or ServeMux).
+// With a configured base path the routes are registered below it.
+func (crc *cleanRouterCore) RegisterRoutesWithAdapter(routerAdapter interfaces.RouterAdapter) error {
+	basePath := crc.config.GetRouterBasePath()
+	crc.logger.Info("Registering routes", zap.String("base_path", basePath))
+
+	// Generated URL builders link below the same prefix
+	shared.SetURLBasePath(basePath)
 
 	// Note: Router middleware should be configured BEFORE calling RegisterRoutes
 	// This is now handled in the application layer to ensure proper middleware order
 
+	router, err := routerAdapter.Mount(basePath)
+	if err != nil {
+		return fmt.Errorf("failed to mount router at %q: %w", basePath, err)
+	}
+
 	// Create route registrar through DI to ensure proper ConfigService injection
-	routeRegistrar, err := NewRouteRegistrar(crc.injector, chiRouter)
+	routeRegistrar, err := NewRouteRegistrar(crc.injector, router)
 	if err != nil {
 		return fmt.Errorf("failed to create route registrar: %w", err)
 	}
 	crc.routeRegistrar = routeRegistrar
 
+	// Register redirects and rewrites before page routes
+	if err := crc.routeRegistrar.RegisterRedirects(crc.redirects); err != nil {
+		return fmt.Errorf("failed to register redirects: %w", err)
+	}
+
 	// Convert routes to interfaces.Route format
 	interfaceRoutes := crc.convertToInterfaceRoutes(crc.routes)
 
@@ -143,32 +182,35 @@ func (crc *cleanRouterCore) RegisterRoutes(chiRouter *chi.Mux) error {
 	}
 
 	// Register static routes
-	crc.routeRegistrar.RegisterStaticRoutes()
+	if err := crc.routeRegistrar.RegisterStaticRoutes(); err != nil {
+		return fmt.Errorf("failed to register static routes: %w", err)
+	}
 
 	// Register authentication handlers
-
+	var authErr error
 	crc.authHandlers.RegisterRoutes(func(method, path string, handler http.HandlerFunc) {
 		switch method {
-		case "GET":
-			chiRouter.Get(path, handler)
-		case "POST":
-			chiRouter.Post(path, handler)
-		case "PUT":
-			chiRouter.Put(path, handler)
-		case "DELETE":
-			chiRouter.Delete(path, handler)
-		case "PATCH":
-			chiRouter.Patch(path, handler)
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodPatch:
+			if err := router.Handle(method, path, handler); err != nil && authErr == nil {
+				authErr = err
+			}
 		default:
 			crc.logger.Warn("Unsupported HTTP method for auth handler",
 				zap.String("method", method),
 				zap.String("path", path))
 		}
 	})
+	if authErr != nil {
+		return fmt.Errorf("failed to register auth routes: %w", authErr)
+	}
 
 	// Register error handlers
-	crc.routeRegistrar.Register404Handler()
-	crc.routeRegistrar.RegisterMethodNotAllowedHandler()
+	if err := crc.routeRegistrar.Register404Handler(); err != nil {
+		return fmt.Errorf("failed to register 404 handler: %w", err)
+	}
+	if err := crc.routeRegistrar.RegisterMethodNotAllowedHandler(); err != nil {
+		return fmt.Errorf("failed to register 405 handler: %w", err)
+	}
 
 	crc.logger.Info("All routes registered successfully",
 		zap.Int("total_routes", len(crc.routes)))
@@ -185,8 +227,12 @@ func (crc *cleanRouterCore) convertToInterfaceRoutes(routes []interfaces.Route)
 			Path:                 route.Path,
 			TemplateFile:         route.TemplateFile,
 			IsDynamic:            route.IsDynamic,
+			Handler:              route.Handler,
+			Precedence:           route.Precedence,
 			RequiresDataService:  route.RequiresDataService,
 			DataServiceInterface: route.DataServiceInterface,
+			HasAction:            route.HasAction,
+			IsHandler:            route.IsHandler,
 		}
 	}
 
@@ -223,6 +269,108 @@ func (crc *cleanRouterCore) GetRouteRegistrar() RouteRegistrar {
 	return crc.routeRegistrar
 }
 
+// GetRedirects returns all declared redirect and rewrite rules
+func (crc *cleanRouterCore) GetRedirects() []interfaces.RedirectRule {
+	return crc.redirects
+}
+
+// GetValidationResult returns the result of the startup validation (nil when validation is off)
+func (crc *cleanRouterCore) GetValidationResult() *interfaces.ValidationResult {
+	return crc.validationResult
+}
+
+// validateRoutes runs the validation orchestrator according to the configured validation mode
+func (crc *cleanRouterCore) validateRoutes(configs map[string]*interfaces.ConfigFile, loadErrors *interfaces.ValidationResult) error {
+	mode := crc.config.GetRouterValidationMode()
+
+	// A redirect loop is never valid configuration, so it aborts startup in every mode
+	redirectResult := crc.validationService.ValidateRedirects(crc.redirects, crc.routes)
+	if loops := redirectResult.GetErrorsByType("REDIRECT_LOOP"); len(loops) > 0 {
+		return shared.NewConfigurationError("redirect loop", loops[0].Message).
+			WithContext("mode", mode).
+			WithContext("source", loops[0].FilePath).
+			WithContext("from", loops[0].RoutePath).
+			WithContext("loops", len(loops))
+	}
+
+	if mode == interfaces.ValidationModeOff {
+		crc.logger.Info("Startup route validation disabled")
+		crc.validationResult = nil
+		return nil
+	}
+
+	result := crc.validationService.Validate(crc.routes, configs)
+	result.Merge(loadErrors)
+	result.Merge(redirectResult)
+	crc.validationResult = result
+
+	if !result.HasErrors() {
+		crc.logger.Info("Startup route validation passed",
+			zap.String("mode", mode),
+			zap.Int("warnings", result.GetWarningCount()))
+		return nil
+	}
+
+	if mode == interfaces.ValidationModeStrict {
+		return shared.NewConfigurationError("route validation failed",
+			fmt.Sprintf("%d errors and %d warnings", result.GetErrorCount(), result.GetWarningCount())).
+			WithContext("mode", mode).
+			WithContext("first_error", result.Errors[0].Message).
+			WithContext("first_error_route", result.Errors[0].RoutePath)
+	}
+
+	crc.logger.Warn("Startup route validation found errors, continuing in warn mode",
+		zap.Int("errors", result.GetErrorCount()),
+		zap.Int("warnings", result.GetWarningCount()))
+
+	return nil
+}
+
+// loadRouteConfigs loads the ConfigFile of every discovered route keyed by template file
+func (crc *cleanRouterCore) loadRouteConfigs() (map[string]*interfaces.ConfigFile, *interfaces.ValidationResult) {
+	configs := make(map[string]*interfaces.ConfigFile, len(crc.routes))
+	loadErrors := &interfaces.ValidationResult{}
+
+	for _, route := range crc.routes {
+		if _, loaded := configs[route.TemplateFile]; loaded {
+			continue
+		}
+
+		config, err := crc.configLoader.LoadRouteConfig(route.TemplateFile)
+		if err != nil {
+			loadErrors.AddError("CONFIG_LOAD_ERROR", err.Error(), route.TemplateFile, route.Path,
+				"Fix the YAML syntax of the template configuration")
+			continue
+		}
+		configs[route.TemplateFile] = config
+	}
+
+	return configs, loadErrors
+}
+
+// collectRedirects gathers the rules of the global redirects.yaml and of all route configurations.
+// Global rules come first; a broken redirects.yaml is logged and skipped.
+func (crc *cleanRouterCore) collectRedirects(configs map[string]*interfaces.ConfigFile) []interfaces.RedirectRule {
+	redirects, err := crc.configLoader.LoadRedirects(crc.scanPath)
+	if err != nil {
+		crc.logger.Error("Failed to load global redirects", zap.Error(err))
+		redirects = nil
+	}
+
+	// Iterate routes instead of the map so the rule order is deterministic
+	seen := make(map[string]bool, len(configs))
+	for _, route := range crc.routes {
+		config, ok := configs[route.TemplateFile]
+		if !ok || config == nil || seen[route.TemplateFile] {
+			continue
+		}
+		seen[route.TemplateFile] = true
+		redirects = append(redirects, config.Redirects...)
+	}
+
+	return redirects
+}
+
 // loadTranslationsForDiscoveredRoutes loads translations for all discovered routes
 func (crc *cleanRouterCore) loadTranslationsForDiscoveredRoutes() error {
 	crc.logger.Info("Loading translations for discovered routes", zap.Int("route_count", len(crc.routes)))
@@ -2,15 +2,21 @@ package router
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/a-h/templ"
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/adapter"
 	"github.com/denkhaus/templ-router/pkg/router/i18n"
 	"github.com/denkhaus/templ-router/pkg/router/middleware"
 	"github.com/denkhaus/templ-router/pkg/router/pipeline"
+	"github.com/denkhaus/templ-router/pkg/services/auth"
+	"github.com/denkhaus/templ-router/pkg/services/ratelimit"
 	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/go-chi/chi/v5"
 	"github.com/samber/do/v2"
@@ -50,6 +56,7 @@ func (m *mockRouterConfigService) IsEmailVerificationRequired() bool         { r
 func (m *mockRouterConfigService) GetVerificationTokenExpiry() time.Duration { return 24 * time.Hour }
 func (m *mockRouterConfigService) GetSessionCookieName() string              { return "session" }
 func (m *mockRouterConfigService) GetSessionExpiry() time.Duration           { return 24 * time.Hour }
+func (m *mockRouterConfigService) GetSessionRememberExpiry() time.Duration   { return 30 * 24 * time.Hour }
 func (m *mockRouterConfigService) IsSessionSecure() bool                     { return false }
 func (m *mockRouterConfigService) IsSessionHttpOnly() bool                   { return true }
 func (m *mockRouterConfigService) GetSessionSameSite() string                { return "lax" }
@@ -77,14 +84,19 @@ func (m *mockRouterConfigService) GetFromEmail() string           { return "nore
 func (m *mockRouterConfigService) GetFromName() string            { return "App" }
 func (m *mockRouterConfigService) GetReplyToEmail() string        { return "" }
 func (m *mockRouterConfigService) IsEmailDummyModeEnabled() bool  { return true }
+func (m *mockRouterConfigService) IsCSRFEnabled() bool            { return false }
 func (m *mockRouterConfigService) GetCSRFSecret() string          { return "secret" }
 func (m *mockRouterConfigService) IsCSRFSecure() bool             { return false }
 func (m *mockRouterConfigService) IsCSRFHttpOnly() bool           { return true }
 func (m *mockRouterConfigService) GetCSRFSameSite() string        { return "strict" }
+func (m *mockRouterConfigService) GetCSRFExemptPaths() []string   { return nil }
 func (m *mockRouterConfigService) IsRateLimitEnabled() bool       { return false }
 func (m *mockRouterConfigService) GetRateLimitRequests() int      { return 100 }
+func (m *mockRouterConfigService) IsRateLimitAllRoutesEnabled() bool { return false }
+func (m *mockRouterConfigService) GetTrustedProxies() []string    { return nil }
 func (m *mockRouterConfigService) IsHSTSEnabled() bool            { return false }
 func (m *mockRouterConfigService) GetHSTSMaxAge() int             { return 31536000 }
+func (m *mockRouterConfigService) GetContentSecurityPolicy() string { return "" }
 func (m *mockRouterConfigService) GetLogLevel() string            { return "info" }
 func (m *mockRouterConfigService) GetLogFormat() string           { return "json" }
 func (m *mockRouterConfigService) GetLogOutput() string           { return "stdout" }
@@ -97,11 +109,14 @@ func (m *mockRouterConfigService) IsProduction() bool             { return false
 func (m *mockRouterConfigService) GetRouterEnableTrailingSlash() bool    { return true }
 func (m *mockRouterConfigService) GetRouterEnableSlashRedirect() bool    { return true }
 func (m *mockRouterConfigService) GetRouterEnableMethodNotAllowed() bool { return true }
+func (m *mockRouterConfigService) GetRouterParameterValidationStatus() int { return 404 }
+func (m *mockRouterConfigService) GetRouterValidationMode() string { return "warn" }
+func (m *mockRouterConfigService) GetRouterBasePath() string { return "" }
+func (m *mockRouterConfigService) GetRouterRenderMode() string { return "direct" }
 
 type mockRouterAssetsService struct{}
 
-func (m *mockRouterAssetsService) SetupRoutes(router *chi.Mux)             {}
-func (m *mockRouterAssetsService) SetupRoutesWithRouter(router chi.Router) {}
+func (m *mockRouterAssetsService) SetupRoutes(router interfaces.RouterAdapter) error { return nil }
 
 type mockRouterTemplateRegistry struct{}
 
@@ -123,6 +138,23 @@ func (m *mockRouterTemplateRegistry) GetTemplateByRoute(route string) (templ.Com
 	return nil, nil
 }
 
+func (m *mockRouterTemplateRegistry) GetComponentTemplateKey(componentKey string) (string, bool) {
+	return "", false
+}
+
+// GetAction retrieves the Action function of a page package
+func (m *mockRouterTemplateRegistry) GetAction(actionKey string) (interfaces.ActionFunc, bool) {
+	return nil, false
+}
+
+func (m *mockRouterTemplateRegistry) GetRouteHandlerMapping() map[string]string {
+	return map[string]string{}
+}
+
+func (m *mockRouterTemplateRegistry) GetRouteHandler(handlerKey string) (http.Handler, bool) {
+	return nil, false
+}
+
 func (m *mockRouterTemplateRegistry) RequiresDataService(key string) bool {
 	return false
 }
@@ -166,6 +198,10 @@ func (m *mockConfigLoader) LoadRouteConfig(templatePath string) (*interfaces.Con
 	return &interfaces.ConfigFile{}, nil
 }
 
+func (m *mockConfigLoader) LoadRedirects(rootDir string) ([]interfaces.RedirectRule, error) {
+	return nil, nil
+}
+
 type mockAuthService struct{}
 
 func (m *mockAuthService) Authenticate(req *http.Request, requirements *interfaces.AuthSettings) (*interfaces.AuthResult, error) {
@@ -279,6 +315,10 @@ func (m *mockTemplateService) RenderComponent(route interfaces.Route, routerCtx
 	return nil, nil
 }
 
+func (m *mockTemplateService) RenderFragment(route interfaces.Route, fragment string, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error) {
+	return nil, nil
+}
+
 func (m *mockTemplateService) RenderLayoutComponent(layoutPath string, content templ.Component, ctx context.Context) (templ.Component, error) {
 	return content, nil
 }
@@ -303,6 +343,10 @@ func (m *mockLayoutService) FindLayoutForTemplate(templatePath string) *interfac
 	}
 }
 
+func (m *mockLayoutService) FindNamedLayout(name string) *interfaces.LayoutTemplate {
+	return nil
+}
+
 func (m *mockLayoutService) WrapInLayout(component templ.Component, layout *interfaces.LayoutTemplate, ctx context.Context) templ.Component {
 	return component
 }
@@ -321,6 +365,25 @@ func (m *mockErrorService) CreateErrorComponent(message, path string) templ.Comp
 	return nil
 }
 
+func (m *mockErrorService) CreateErrorInfoComponent(info *interfaces.ErrorInfo, lookupPath string) templ.Component {
+	return nil
+}
+
+// Mock DataServiceResolver
+type mockDataServiceResolver struct{}
+
+func (m *mockDataServiceResolver) ResolveDataService(interfaceType string) (interface{}, error) {
+	return nil, errors.New("data service not registered")
+}
+
+func (m *mockDataServiceResolver) ResolveGenericDataService(interfaceType string) (interfaces.GenericDataService, error) {
+	return nil, errors.New("data service not registered")
+}
+
+func (m *mockDataServiceResolver) HasDataService(interfaceType string) bool {
+	return false
+}
+
 // Mock Middleware Interfaces
 type mockAuthMiddleware struct{}
 
@@ -390,9 +453,9 @@ func (m *mockTemplateMiddleware) HandleTemplateError(err error, w http.ResponseW
 // Mock RouterMiddleware
 type mockRouterMiddleware struct{}
 
-func (m *mockRouterMiddleware) ConfigureRouterMiddleware(chiRouter *chi.Mux) error {
+func (m *mockRouterMiddleware) ConfigureRouterMiddleware(next http.Handler) (http.Handler, error) {
 	// Mock implementation - do nothing
-	return nil
+	return next, nil
 }
 
 // Mock AuthHandlers for router tests
@@ -433,6 +496,42 @@ func (m *mockRouterAuthHandlers) HandleSignUp(w http.ResponseWriter, r *http.Req
 	w.Write([]byte("Signup handled"))
 }
 
+// mockValidationService returns a fixed result and records the validated configs
+type mockValidationService struct {
+	result    *interfaces.ValidationResult
+	redirects *interfaces.ValidationResult
+	called    bool
+	configs   map[string]*interfaces.ConfigFile
+}
+
+func (m *mockValidationService) ValidateConfiguration(routes []interfaces.Route, configs map[string]*interfaces.ConfigFile) error {
+	return nil
+}
+
+func (m *mockValidationService) ValidateRedirects(rules []interfaces.RedirectRule, routes []interfaces.Route) *interfaces.ValidationResult {
+	if m.redirects == nil {
+		return &interfaces.ValidationResult{}
+	}
+	return m.redirects
+}
+
+func (m *mockValidationService) Validate(routes []interfaces.Route, configs map[string]*interfaces.ConfigFile) *interfaces.ValidationResult {
+	m.called = true
+	m.configs = configs
+	if m.result == nil {
+		return &interfaces.ValidationResult{}
+	}
+	return m.result
+}
+
+// validationModeConfigService overrides the startup validation mode
+type validationModeConfigService struct {
+	mockRouterConfigService
+	mode string
+}
+
+func (m *validationModeConfigService) GetRouterValidationMode() string { return m.mode }
+
 func createRouterTestContainer() do.Injector {
 	injector := do.New()
 
@@ -443,6 +542,7 @@ func createRouterTestContainer() do.Injector {
 	do.ProvideValue[interfaces.TemplateRegistry](injector, &mockRouterTemplateRegistry{})
 	do.ProvideValue[RouteDiscovery](injector, &mockRouteDiscovery{})
 	do.ProvideValue[ConfigLoader](injector, &mockConfigLoader{})
+	do.ProvideValue[interfaces.ValidationService](injector, &mockValidationService{})
 	do.ProvideValue[interfaces.AuthService](injector, &mockAuthService{})
 	do.ProvideValue[interfaces.I18nService](injector, &mockI18nService{})
 	do.ProvideValue[interfaces.TemplateService](injector, &mockTemplateService{})
@@ -450,11 +550,20 @@ func createRouterTestContainer() do.Injector {
 
 	// Register ErrorService (required by middleware setup)
 	do.ProvideValue[interfaces.ErrorService](injector, &mockErrorService{})
+	do.ProvideValue[interfaces.DataServiceResolver](injector, &mockDataServiceResolver{})
 
 	// Register middleware interfaces (required by middleware setup)
 	do.ProvideValue[middleware.AuthMiddlewareInterface](injector, &mockAuthMiddleware{})
 	do.ProvideValue[middleware.I18nMiddlewareInterface](injector, &mockI18nMiddleware{})
 	do.ProvideValue[middleware.TemplateMiddlewareInterface](injector, &mockTemplateMiddleware{})
+	do.Provide(injector, middleware.NewParameterValidationMiddleware)
+	do.Provide(injector, middleware.NewRecoveryMiddleware)
+	do.Provide(injector, middleware.NewContentNegotiationMiddleware)
+	do.Provide(injector, middleware.NewActionMiddleware)
+	do.Provide(injector, ratelimit.NewInMemoryRateLimiter)
+	do.Provide(injector, auth.NewInMemorySessionStore)
+	do.Provide(injector, middleware.NewRateLimitMiddleware)
+	do.Provide(injector, middleware.NewSecurityHeadersMiddleware)
 	do.ProvideValue[middleware.RouterMiddlewareInterface](injector, &mockRouterMiddleware{})
 
 	// Register AuthHandlers (required by RegisterRoutes)
@@ -621,3 +730,174 @@ func TestConvertToInterfaceRoutes(t *testing.T) {
 		}
 	}
 }
+
+func TestCleanRouterCoreStartupValidation(t *testing.T) {
+	failing := &interfaces.ValidationResult{}
+	failing.AddError("AMBIGUOUS_ROUTES", "Routes are ambiguous", "app/page.templ", "/")
+
+	tests := []struct {
+		name          string
+		mode          string
+		result        *interfaces.ValidationResult
+		expectError   bool
+		expectCalled  bool
+		expectErrors  int
+		expectNilInfo bool
+	}{
+		{name: "strict aborts on errors", mode: interfaces.ValidationModeStrict, result: failing, expectError: true, expectCalled: true, expectErrors: 1},
+		{name: "strict passes clean result", mode: interfaces.ValidationModeStrict, expectCalled: true},
+		{name: "warn keeps running", mode: interfaces.ValidationModeWarn, result: failing, expectCalled: true, expectErrors: 1},
+		{name: "off skips validation", mode: interfaces.ValidationModeOff, result: failing, expectNilInfo: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			injector := createRouterTestContainer()
+			validationService := &mockValidationService{result: tt.result}
+			do.OverrideValue[interfaces.ValidationService](injector, validationService)
+			do.OverrideValue[interfaces.ConfigService](injector, &validationModeConfigService{mode: tt.mode})
+
+			router, err := NewCleanRouterCore(injector)
+			if err != nil {
+				t.Fatalf("Failed to create router: %v", err)
+			}
+
+			err = router.Initialize()
+			if tt.expectError != (err != nil) {
+				t.Fatalf("Initialize() error = %v, expectError %v", err, tt.expectError)
+			}
+			if validationService.called != tt.expectCalled {
+				t.Errorf("validation called = %v, want %v", validationService.called, tt.expectCalled)
+			}
+
+			result := router.GetValidationResult()
+			if tt.expectNilInfo {
+				if result != nil {
+					t.Errorf("GetValidationResult() = %v, want nil", result)
+				}
+				return
+			}
+			if result == nil {
+				t.Fatal("GetValidationResult() returned nil")
+			}
+			if result.GetErrorCount() != tt.expectErrors {
+				t.Errorf("GetValidationResult() errors = %d, want %d", result.GetErrorCount(), tt.expectErrors)
+			}
+			if _, ok := validationService.configs["app/locale_/page.templ"]; !ok {
+				t.Error("route configs were not loaded before validation")
+			}
+		})
+	}
+}
+
+func TestCleanRouterCoreRedirectLoopAbortsStartup(t *testing.T) {
+	modes := []string{interfaces.ValidationModeStrict, interfaces.ValidationModeWarn, interfaces.ValidationModeOff}
+
+	for _, mode := range modes {
+		t.Run(mode, func(t *testing.T) {
+			loop := &interfaces.ValidationResult{}
+			loop.AddError("REDIRECT_LOOP", "Redirect loop: /a -> /b -> /a", "app/page.templ.yaml", "/a")
+
+			injector := createRouterTestContainer()
+			do.OverrideValue[interfaces.ValidationService](injector, &mockValidationService{redirects: loop})
+			do.OverrideValue[interfaces.ConfigService](injector, &validationModeConfigService{mode: mode})
+
+			router, err := NewCleanRouterCore(injector)
+			if err != nil {
+				t.Fatalf("Failed to create router: %v", err)
+			}
+
+			err = router.Initialize()
+			if err == nil {
+				t.Fatal("Initialize() succeeded despite a redirect loop")
+			}
+			if !strings.Contains(err.Error(), "/a -> /b -> /a") {
+				t.Errorf("Initialize() error = %v, want the loop chain", err)
+			}
+		})
+	}
+}
+
+// basePathConfigService overrides the router mount prefix
+type basePathConfigService struct {
+	mockRouterConfigService
+	basePath string
+}
+
+func (m *basePathConfigService) GetRouterBasePath() string { return m.basePath }
+
+func TestCleanRouterCoreRegisterRoutesWithBasePath(t *testing.T) {
+	injector := createRouterTestContainer()
+	do.OverrideValue[interfaces.ConfigService](injector, &basePathConfigService{basePath: "/portal"})
+
+	router, err := NewCleanRouterCore(injector)
+	if err != nil {
+		t.Fatalf("Failed to create router: %v", err)
+	}
+	if err := router.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize router: %v", err)
+	}
+
+	mux := chi.NewRouter()
+	if err := router.RegisterRoutes(mux); err != nil {
+		t.Fatalf("RegisterRoutes() returned error: %v", err)
+	}
+
+	tests := []struct {
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{path: "/portal/", expectedCode: http.StatusOK},
+		{path: "/portal/en/missing", expectedCode: http.StatusNotFound},
+		{path: "/", expectedCode: http.StatusNotFound, expectedBody: "404 page not found"},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+		if rec.Code != tt.expectedCode {
+			t.Errorf("GET %s status = %d, want %d", tt.path, rec.Code, tt.expectedCode)
+		}
+		if tt.expectedBody != "" && !strings.Contains(rec.Body.String(), tt.expectedBody) {
+			t.Errorf("GET %s body = %q, want it to contain %q", tt.path, rec.Body.String(), tt.expectedBody)
+		}
+	}
+}
+
+func TestCleanRouterCoreRegisterRoutesWithServeMux(t *testing.T) {
+	injector := createRouterTestContainer()
+	do.OverrideValue[interfaces.ConfigService](injector, &basePathConfigService{basePath: "/portal"})
+
+	router, err := NewCleanRouterCore(injector)
+	if err != nil {
+		t.Fatalf("Failed to create router: %v", err)
+	}
+	if err := router.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize router: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	if err := router.RegisterRoutesWithAdapter(adapter.NewServeMuxAdapter(mux)); err != nil {
+		t.Fatalf("RegisterRoutesWithAdapter() returned error: %v", err)
+	}
+
+	tests := []struct {
+		path         string
+		expectedCode int
+	}{
+		{path: "/portal/", expectedCode: http.StatusOK},
+		{path: "/portal/en/missing", expectedCode: http.StatusNotFound},
+		{path: "/", expectedCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+		if rec.Code != tt.expectedCode {
+			t.Errorf("GET %s status = %d, want %d", tt.path, rec.Code, tt.expectedCode)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package router
+
+import (
+	"context"
+
+	"github.com/a-h/templ"
+)
+
+// CSPNonce retrieves the Content-Security-Policy nonce of the current request from context,
+// e.g. for <script nonce={ router.CSPNonce(ctx) }>
+func CSPNonce(ctx context.Context) string {
+	return templ.GetNonce(ctx)
+}
@@ -0,0 +1,41 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"html"
+	"io"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/shared"
+)
+
+// CSRFToken retrieves the CSRF token of the current request from context
+func CSRFToken(ctx context.Context) string {
+	token, _ := ctx.Value(shared.CSRFTokenKey).(string)
+	return token
+}
+
+// CSRFField renders the hidden csrf_token input for forms
+func CSRFField() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, `<input type="hidden" name="`+shared.CSRFFieldName+
+			`" value="`+html.EscapeString(CSRFToken(ctx))+`"/>`)
+		return err
+	})
+}
+
+// CSRFMeta renders the csrf-token meta tag for scripts that send the X-CSRF-Token header
+func CSRFMeta() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, `<meta name="csrf-token" content="`+html.EscapeString(CSRFToken(ctx))+`"/>`)
+		return err
+	})
+}
+
+// CSRFHeaders returns the X-CSRF-Token header as JSON for the hx-headers attribute,
+// so all HTMX requests below the element carry the token
+func CSRFHeaders(ctx context.Context) string {
+	headers, _ := json.Marshal(map[string]string{shared.CSRFHeaderName: CSRFToken(ctx)})
+	return string(headers)
+}
@@ -36,8 +36,9 @@ type DynamicRouteSegment struct {
 	Config *interfaces.DynamicParameterConfig
 }
 
-// RecognizeDynamicRoutes identifies dynamic route patterns using dollar sign convention
+// RecognizeDynamicRoutes identifies dynamic route patterns using dollar sign or chi brace convention
 // Only $locale is reserved for localization, all other parameters use the $ prefix (e.g., $id, $slug)
+// or the equivalent chi form (e.g., {id}, {slug})
 func RecognizeDynamicRoutes(routePath string, templateName string) []DynamicRouteSegment {
 	return RecognizeDynamicRoutesWithConfig(routePath, templateName, nil)
 }
@@ -50,8 +51,7 @@ func RecognizeDynamicRoutesWithConfig(routePath string, templateName string, dyn
 	parts := strings.Split(routePath, "/")
 
 	for _, part := range parts {
-		if strings.HasPrefix(part, "$") && len(part) > 1 {
-			parameterName := part[1:] // Remove the $ prefix
+		if parameterName, ok := shared.ParseRouteParamSegment(part); ok {
 			pattern := part
 
 			segment := DynamicRouteSegment{
@@ -90,7 +90,7 @@ func RecognizeDynamicRoutesWithConfig(routePath string, templateName string, dyn
 
 // IsDynamicRoute checks if a route path contains dynamic segments
 func IsDynamicRoute(routePath string) bool {
-	return strings.Contains(routePath, "/$")
+	return shared.IsDynamicRoutePattern(routePath)
 }
 
 // ValidateDynamicSegmentValue validates a value against the segment's validation regex
@@ -1,5 +1,12 @@
 package router
 
+import (
+	"context"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+)
+
 // ErrorTemplate represents an error.templ file for error page presentation
 type ErrorTemplate struct {
 	// FilePath is the full path to the error.templ file
@@ -20,3 +27,16 @@ type ErrorTemplate struct {
 	// ErrorMessages contains mapping of error codes to specific messages
 	ErrorMessages map[int]string
 }
+
+// GetErrorInfo returns the error info of the error page being rendered (nil outside error templates)
+func GetErrorInfo(ctx context.Context) *interfaces.ErrorInfo {
+	info, _ := ctx.Value(shared.ErrorInfoKey).(*interfaces.ErrorInfo)
+	return info
+}
+
+// GetActionResult returns the result of the page action when a form submission renders the
+// page again (nil for other requests), e.g. to show result.FieldError("email")
+func GetActionResult(ctx context.Context) *interfaces.ActionResult {
+	result, _ := ctx.Value(shared.ActionResultKey).(*interfaces.ActionResult)
+	return result
+}
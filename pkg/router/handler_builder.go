@@ -79,7 +79,11 @@ func (hb *handlerBuilder) BuildHandler(route interfaces.Route) http.Handler {
 	// Add config file if available
 	if config != nil {
 		pipelineConfig.ConfigFile = &pipeline.ConfigFile{
-			AuthSettings: authSettings,
+			AuthSettings:      authSettings,
+			DynamicSettings:   config.DynamicSettings,
+			APISettings:       config.APISettings,
+			RateLimitSettings: config.RateLimitSettings,
+			SecuritySettings:  config.SecuritySettings,
 		}
 	}
 
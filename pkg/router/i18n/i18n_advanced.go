@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+
+	"github.com/denkhaus/templ-router/pkg/shared"
 )
 
 // TWithParams translates a key with parameter substitution
@@ -18,3 +20,19 @@ func TWithParams(ctx context.Context, key string, params map[string]string) stri
 
 	return translation
 }
+
+// TOrDefault translates a key, returning fallback when the context has no translation for it
+func TOrDefault(ctx context.Context, key, fallback string) string {
+	data, ok := ctx.Value(shared.I18nDataKey).(*I18nData)
+	if !ok || key == "" {
+		return fallback
+	}
+
+	data.mu.RLock()
+	defer data.mu.RUnlock()
+
+	if translation, exists := data.Translations[key]; exists {
+		return translation
+	}
+	return fallback
+}
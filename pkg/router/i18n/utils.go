@@ -6,11 +6,12 @@ import (
 	"strings"
 
 	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/shared"
 )
 
 func LocalizePath(ctx context.Context, path string) string {
 	locale := GetCurrentLocale(ctx)
-	return fmt.Sprintf("/%s%s", locale, path)
+	return shared.JoinBasePath(shared.GetBasePath(ctx), fmt.Sprintf("/%s%s", locale, path))
 }
 
 func LocalizeSafeURL(ctx context.Context, path string) templ.SafeURL {
@@ -128,3 +128,15 @@ func TestLocalizeRouteIfRequired_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalizePathWithBasePath(t *testing.T) {
+	ctx := context.WithValue(context.Background(), shared.LocaleKey, "de")
+	if got := LocalizePath(ctx, "/dashboard"); got != "/de/dashboard" {
+		t.Errorf("LocalizePath() = %q, want %q", got, "/de/dashboard")
+	}
+
+	ctx = shared.WithBasePath(ctx, "/portal")
+	if got := LocalizePath(ctx, "/dashboard"); got != "/portal/de/dashboard" {
+		t.Errorf("LocalizePath() = %q, want %q", got, "/portal/de/dashboard")
+	}
+}
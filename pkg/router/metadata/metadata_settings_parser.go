@@ -1,6 +1,9 @@
 package metadata
 
 import (
+	"strings"
+	"time"
+
 	"github.com/denkhaus/templ-router/pkg/interfaces"
 )
 
@@ -72,6 +75,54 @@ func (msp *MetadataSettingsParser) ParseAuthSettings(authData interface{}) *inte
 	return settings
 }
 
+// ParseLayoutSettings parses layout settings from YAML into LayoutSettings struct.
+// Accepts "layout: none", "layout: <name>" and "layout: { name: <name>, inherit: false }".
+func (msp *MetadataSettingsParser) ParseLayoutSettings(layoutData interface{}) *interfaces.LayoutSettings {
+	settings := &interfaces.LayoutSettings{Inherit: true}
+
+	if layoutName, ok := layoutData.(string); ok {
+		msp.applyLayoutName(settings, layoutName)
+		return settings
+	}
+
+	layoutMap, ok := layoutData.(map[interface{}]interface{})
+	if !ok {
+		// Try string-keyed map
+		if layoutMapStr, ok := layoutData.(map[string]interface{}); ok {
+			layoutMap = make(map[interface{}]interface{})
+			for k, v := range layoutMapStr {
+				layoutMap[k] = v
+			}
+		} else {
+			return settings
+		}
+	}
+
+	if inherit, exists := layoutMap["inherit"]; exists {
+		if inheritBool, ok := inherit.(bool); ok {
+			settings.Inherit = inheritBool
+		}
+	}
+
+	if name, exists := layoutMap["name"]; exists {
+		if nameStr, ok := name.(string); ok {
+			msp.applyLayoutName(settings, nameStr)
+		}
+	}
+
+	return settings
+}
+
+// applyLayoutName sets the layout name, treating "none" as layout opt-out
+func (msp *MetadataSettingsParser) applyLayoutName(settings *interfaces.LayoutSettings, name string) {
+	name = strings.TrimSpace(name)
+	if strings.EqualFold(name, interfaces.LayoutNone) {
+		settings.None = true
+		return
+	}
+	settings.Name = name
+}
+
 // ParseDynamicSettings parses dynamic parameter settings from YAML into DynamicSettings struct
 func (msp *MetadataSettingsParser) ParseDynamicSettings(dynamicData interface{}) *interfaces.DynamicSettings {
 	if dynamicData == nil {
@@ -143,6 +194,18 @@ func (msp *MetadataSettingsParser) ParseDynamicSettings(dynamicData interface{})
 			}
 		}
 
+		// Parse parameter type and date format
+		if paramType, exists := paramConfig["type"]; exists {
+			if paramTypeStr, ok := paramType.(string); ok {
+				config.Type = paramTypeStr
+			}
+		}
+		if format, exists := paramConfig["format"]; exists {
+			if formatStr, ok := format.(string); ok {
+				config.Format = formatStr
+			}
+		}
+
 		// Parse description
 		if description, exists := paramConfig["description"]; exists {
 			if descriptionStr, ok := description.(string); ok {
@@ -167,3 +230,154 @@ func (msp *MetadataSettingsParser) ParseDynamicSettings(dynamicData interface{})
 
 	return settings
 }
+
+// ParseAPISettings parses the "api" section, e.g. "api: { json: true }", into APISettings
+func (msp *MetadataSettingsParser) ParseAPISettings(apiData interface{}) *interfaces.APISettings {
+	apiMap, ok := apiData.(map[interface{}]interface{})
+	if !ok {
+		// Try string-keyed map
+		if apiMapStr, ok := apiData.(map[string]interface{}); ok {
+			apiMap = make(map[interface{}]interface{})
+			for k, v := range apiMapStr {
+				apiMap[k] = v
+			}
+		} else {
+			return nil
+		}
+	}
+
+	settings := &interfaces.APISettings{}
+	if jsonEnabled, exists := apiMap["json"]; exists {
+		if jsonBool, ok := jsonEnabled.(bool); ok {
+			settings.JSON = jsonBool
+		}
+	}
+
+	return settings
+}
+
+// ParseRateLimitSettings parses the "rate_limit" section, e.g. "rate_limit: { requests: 10, per: 1m }",
+// into RateLimitSettings. It returns nil for malformed sections.
+func (msp *MetadataSettingsParser) ParseRateLimitSettings(rateLimitData interface{}) *interfaces.RateLimitSettings {
+	rateLimitMap, ok := rateLimitData.(map[interface{}]interface{})
+	if !ok {
+		// Try string-keyed map
+		if rateLimitMapStr, ok := rateLimitData.(map[string]interface{}); ok {
+			rateLimitMap = make(map[interface{}]interface{})
+			for k, v := range rateLimitMapStr {
+				rateLimitMap[k] = v
+			}
+		} else {
+			return nil
+		}
+	}
+
+	settings := &interfaces.RateLimitSettings{
+		Per: interfaces.DefaultRateLimitPeriod,
+		Key: interfaces.RateLimitKeyIP,
+	}
+
+	requests, ok := rateLimitMap["requests"].(int)
+	if !ok || requests < 1 {
+		return nil
+	}
+	settings.Requests = requests
+
+	if per, exists := rateLimitMap["per"]; exists {
+		perStr, ok := per.(string)
+		if !ok {
+			return nil
+		}
+		duration, err := time.ParseDuration(perStr)
+		if err != nil || duration <= 0 {
+			return nil
+		}
+		settings.Per = duration
+	}
+
+	if key, exists := rateLimitMap["key"]; exists {
+		switch keyStr, _ := key.(string); interfaces.RateLimitKey(keyStr) {
+		case interfaces.RateLimitKeyIP, interfaces.RateLimitKeySession, interfaces.RateLimitKeyRoute:
+			settings.Key = interfaces.RateLimitKey(keyStr)
+		default:
+			return nil
+		}
+	}
+
+	return settings
+}
+
+// ParseSecuritySettings parses the "security" section, e.g.
+// "security: { content_security_policy: \"script-src 'self' 'nonce-{nonce}'\", frame_options: sameorigin }",
+// into SecuritySettings. Unknown frame options are ignored, so the route keeps DENY.
+func (msp *MetadataSettingsParser) ParseSecuritySettings(securityData interface{}) *interfaces.SecuritySettings {
+	securityMap, ok := securityData.(map[interface{}]interface{})
+	if !ok {
+		// Try string-keyed map
+		if securityMapStr, ok := securityData.(map[string]interface{}); ok {
+			securityMap = make(map[interface{}]interface{})
+			for k, v := range securityMapStr {
+				securityMap[k] = v
+			}
+		} else {
+			return nil
+		}
+	}
+
+	settings := &interfaces.SecuritySettings{}
+	if policy, exists := securityMap["content_security_policy"]; exists {
+		if policyStr, ok := policy.(string); ok {
+			settings.ContentSecurityPolicy = strings.TrimSpace(policyStr)
+		}
+	}
+	if frameOptions, exists := securityMap["frame_options"]; exists {
+		if frameOptionsStr, ok := frameOptions.(string); ok {
+			switch value := interfaces.FrameOptions(strings.ToUpper(strings.TrimSpace(frameOptionsStr))); value {
+			case interfaces.FrameOptionsDeny, interfaces.FrameOptionsSameOrigin, interfaces.FrameOptionsNone:
+				settings.FrameOptions = value
+			}
+		}
+	}
+
+	return settings
+}
+
+// ParseRedirects parses a "redirects" or "rewrites" YAML list into redirect rules
+func (msp *MetadataSettingsParser) ParseRedirects(redirectData interface{}, rewrite bool, source string) []interfaces.RedirectRule {
+	entries, ok := redirectData.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var rules []interfaces.RedirectRule
+	for _, entry := range entries {
+		entryMap, ok := entry.(map[interface{}]interface{})
+		if !ok {
+			// Try string-keyed map
+			if entryMapStr, ok := entry.(map[string]interface{}); ok {
+				entryMap = make(map[interface{}]interface{})
+				for k, v := range entryMapStr {
+					entryMap[k] = v
+				}
+			} else {
+				continue
+			}
+		}
+
+		rule := interfaces.RedirectRule{Rewrite: rewrite, Source: source}
+
+		if from, ok := entryMap["from"].(string); ok {
+			rule.From = from
+		}
+		if to, ok := entryMap["to"].(string); ok {
+			rule.To = to
+		}
+		if status, ok := entryMap["status"].(int); ok {
+			rule.Status = status
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+// maxActionFormMemory bounds the multipart form data kept in memory, larger files go to disk
+const maxActionFormMemory = 32 << 20
+
+// actionMiddleware runs the Action function of a page for form submissions (private implementation)
+type actionMiddleware struct {
+	templateRegistry interfaces.TemplateRegistry
+	errorService     interfaces.ErrorService
+	layoutService    interfaces.LayoutService
+	configService    interfaces.ConfigService
+	logger           *zap.Logger
+}
+
+// NewActionMiddleware creates a new action middleware for DI
+func NewActionMiddleware(i do.Injector) (ActionMiddlewareInterface, error) {
+	templateRegistry := do.MustInvoke[interfaces.TemplateRegistry](i)
+	errorService := do.MustInvoke[interfaces.ErrorService](i)
+	layoutService := do.MustInvoke[interfaces.LayoutService](i)
+	configService := do.MustInvoke[interfaces.ConfigService](i)
+	logger := do.MustInvoke[*zap.Logger](i)
+
+	return &actionMiddleware{
+		templateRegistry: templateRegistry,
+		errorService:     errorService,
+		layoutService:    layoutService,
+		configService:    configService,
+		logger:           logger,
+	}, nil
+}
+
+// Handle runs the Action of the page for POST, PUT and DELETE requests. A result with a
+// RedirectURL redirects; any other result renders the page again with the result in its context.
+func (am *actionMiddleware) Handle(next http.Handler, route interfaces.Route) http.Handler {
+	if !route.HasAction {
+		return next
+	}
+
+	action, ok := am.templateRegistry.GetAction(shared.PageActionKey(route.TemplateFile))
+	if !ok {
+		am.logger.Warn("Page action missing from template registry",
+			zap.String("route", route.Path),
+			zap.String("template", route.TemplateFile))
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := r.ParseMultipartForm(maxActionFormMemory); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+			am.renderError(w, r, route, http.StatusBadRequest, err)
+			return
+		}
+
+		result, err := action(NewRouterContext(r.Context(), r))
+		if err != nil {
+			am.logger.Error("Page action failed",
+				zap.String("route", route.Path),
+				zap.String("method", r.Method),
+				zap.Error(err))
+
+			am.renderError(w, r, route, http.StatusInternalServerError, err)
+			return
+		}
+
+		if result.RedirectURL != "" {
+			am.redirect(w, r, result.RedirectURL)
+			return
+		}
+
+		if result.Status == 0 {
+			result.Status = http.StatusOK
+			if result.HasErrors() {
+				result.Status = http.StatusUnprocessableEntity
+			}
+		}
+		if result.Values == nil {
+			result.Values = r.PostForm
+		}
+
+		am.logger.Debug("Rendering page with action result",
+			zap.String("route", route.Path),
+			zap.String("method", r.Method),
+			zap.Int("status", result.Status),
+			zap.Int("errors", len(result.Errors)))
+
+		ctx := context.WithValue(r.Context(), shared.ActionResultKey, &result)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// redirect answers a successful submission with 303 See Other, so reloading the target page
+// does not submit the form again. HTMX requests are redirected by the client.
+// Root-relative targets are below the base path, absolute URLs are used as they are.
+func (am *actionMiddleware) redirect(w http.ResponseWriter, r *http.Request, redirectURL string) {
+	redirectURL = shared.JoinBasePath(shared.GetBasePath(r.Context()), redirectURL)
+
+	if shared.IsHTMXRequest(r) {
+		w.Header().Set(shared.HXRedirectHeader, redirectURL)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+// renderError renders the error template for status nearest to the route's template
+func (am *actionMiddleware) renderError(w http.ResponseWriter, r *http.Request, route interfaces.Route, status int, cause error) {
+	info := NewRequestErrorInfo(r, status, cause)
+	component := am.errorService.CreateErrorInfoComponent(info, ErrorLookupPath(am.configService, route.TemplateFile))
+	if component == nil {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	if status >= http.StatusInternalServerError && am.configService.IsDevelopment() {
+		component = withDevErrorOverlay(component,
+			newDevErrorDiagnostics(am.configService, r, route, status, cause, am.layoutService.FindLayoutForTemplate(route.TemplateFile)))
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(status)
+	if err := component.Render(r.Context(), w); err != nil {
+		am.logger.Error("Failed to render action error page",
+			zap.String("route", route.Path),
+			zap.Int("status", status),
+			zap.Error(err))
+	}
+}
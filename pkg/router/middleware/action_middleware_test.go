@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// actionTestRegistry serves a single page action
+type actionTestRegistry struct {
+	interfaces.TemplateRegistry
+	action interfaces.ActionFunc
+}
+
+func (r *actionTestRegistry) GetAction(actionKey string) (interfaces.ActionFunc, bool) {
+	if actionKey != shared.PageActionKey("app/contact/page.templ") {
+		return nil, false
+	}
+	return r.action, true
+}
+
+// serveWithAction submits form to a contact page whose renderer writes the action result
+func serveWithAction(action interfaces.ActionFunc, r *http.Request) (*httptest.ResponseRecorder, *interfaces.ActionResult) {
+	am := &actionMiddleware{
+		templateRegistry: &actionTestRegistry{action: action},
+		errorService:     &mockParamErrorService{},
+		layoutService:    &recoveryTestLayoutService{},
+		configService:    &errorTestConfigService{production: true},
+		logger:           zap.NewNop(),
+	}
+
+	var rendered *interfaces.ActionResult
+	page := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rendered, _ = r.Context().Value(shared.ActionResultKey).(*interfaces.ActionResult)
+		if rendered != nil {
+			w.WriteHeader(rendered.Status)
+		}
+		w.Write([]byte("contact page"))
+	})
+
+	route := interfaces.Route{Path: "/contact", TemplateFile: "app/contact/page.templ", HasAction: true}
+	rec := httptest.NewRecorder()
+	am.Handle(page, route).ServeHTTP(rec, r)
+	return rec, rendered
+}
+
+func newFormRequest(method string, form url.Values) *http.Request {
+	r := httptest.NewRequest(method, "/contact", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+// contactAction requires an email and redirects to the thank-you page
+func contactAction(routerCtx interfaces.RouterContext) (interfaces.ActionResult, error) {
+	if routerCtx.Request().PostFormValue("email") == "" {
+		return interfaces.ActionResult{Errors: map[string]string{"email": "Email is required"}}, nil
+	}
+	return interfaces.ActionResult{RedirectURL: "/contact/thanks"}, nil
+}
+
+func TestActionMiddlewareRendersPageForGet(t *testing.T) {
+	rec, result := serveWithAction(contactAction, httptest.NewRequest(http.MethodGet, "/contact", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "contact page", rec.Body.String())
+	assert.Nil(t, result)
+}
+
+func TestActionMiddlewareRendersPageWithValidationErrors(t *testing.T) {
+	rec, result := serveWithAction(contactAction, newFormRequest(http.MethodPost, url.Values{"name": {"Jane"}}))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, "contact page", rec.Body.String())
+	if assert.NotNil(t, result) {
+		assert.True(t, result.HasErrors())
+		assert.Equal(t, "Email is required", result.FieldError("email"))
+		assert.Equal(t, "Jane", result.Value("name"))
+	}
+}
+
+func TestActionMiddlewareRedirectsOnSuccess(t *testing.T) {
+	form := url.Values{"email": {"jane@example.com"}}
+
+	rec, result := serveWithAction(contactAction, newFormRequest(http.MethodPost, form))
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	assert.Equal(t, "/contact/thanks", rec.Header().Get("Location"))
+	assert.Nil(t, result)
+
+	htmxRequest := newFormRequest(http.MethodPut, form)
+	htmxRequest.Header.Set(shared.HXRequestHeader, "true")
+	rec, _ = serveWithAction(contactAction, htmxRequest)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "/contact/thanks", rec.Header().Get(shared.HXRedirectHeader))
+}
+
+func TestActionMiddlewareRedirectsBelowBasePath(t *testing.T) {
+	form := url.Values{"email": {"jane@example.com"}}
+	external := func(routerCtx interfaces.RouterContext) (interfaces.ActionResult, error) {
+		return interfaces.ActionResult{RedirectURL: "https://example.com/thanks"}, nil
+	}
+
+	tests := []struct {
+		name     string
+		action   interfaces.ActionFunc
+		htmx     bool
+		expected string
+	}{
+		{"root-relative", contactAction, false, "/portal/contact/thanks"},
+		{"root-relative htmx", contactAction, true, "/portal/contact/thanks"},
+		{"absolute", external, false, "https://example.com/thanks"},
+		{"absolute htmx", external, true, "https://example.com/thanks"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newFormRequest(http.MethodPost, form)
+			r = r.WithContext(shared.WithBasePath(r.Context(), "/portal"))
+			if tt.htmx {
+				r.Header.Set(shared.HXRequestHeader, "true")
+			}
+
+			rec, _ := serveWithAction(tt.action, r)
+			if tt.htmx {
+				assert.Equal(t, tt.expected, rec.Header().Get(shared.HXRedirectHeader))
+			} else {
+				assert.Equal(t, http.StatusSeeOther, rec.Code)
+				assert.Equal(t, tt.expected, rec.Header().Get("Location"))
+			}
+		})
+	}
+}
+
+func TestActionMiddlewareKeepsActionStatus(t *testing.T) {
+	action := func(routerCtx interfaces.RouterContext) (interfaces.ActionResult, error) {
+		return interfaces.ActionResult{Status: http.StatusConflict, Errors: map[string]string{"email": "Already subscribed"}}, nil
+	}
+
+	rec, _ := serveWithAction(action, newFormRequest(http.MethodPost, url.Values{"email": {"jane@example.com"}}))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestActionMiddlewareRendersErrorTemplateOnFailure(t *testing.T) {
+	action := func(routerCtx interfaces.RouterContext) (interfaces.ActionResult, error) {
+		return interfaces.ActionResult{}, errors.New("mail server down")
+	}
+
+	rec, result := serveWithAction(action, newFormRequest(http.MethodDelete, url.Values{}))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "error.templ: error_internal: mail server down", rec.Body.String())
+	assert.Nil(t, result)
+}
@@ -51,6 +51,11 @@ func (acm *AuthContextMiddleware) Middleware(next http.Handler) http.Handler {
 			zap.String("user_id", session.UserID),
 		)
 
+		// The session store extended the session, the cookie must expire with it
+		if session.Renewed {
+			acm.sessionStore.WriteSessionCookie(w, session)
+		}
+
 		// Get user from session
 		user, err := acm.userStore.GetUserByID(session.UserID)
 		if err != nil {
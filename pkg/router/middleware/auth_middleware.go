@@ -1,10 +1,13 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
+	"net/url"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
 	"github.com/denkhaus/templ-router/pkg/router/i18n"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -16,7 +19,9 @@ const (
 // authMiddleware handles authentication concerns separately (private implementation)
 type authMiddleware struct {
 	authService   interfaces.AuthService
+	sessionStore  interfaces.SessionStore
 	configService interfaces.ConfigService
+	errorService  interfaces.ErrorService
 	logger        *zap.Logger
 }
 
@@ -30,12 +35,16 @@ type authMiddleware struct {
 // NewAuthMiddleware creates a new auth middleware for DI
 func NewAuthMiddleware(i do.Injector) (AuthMiddlewareInterface, error) {
 	authService := do.MustInvoke[interfaces.AuthService](i)
+	sessionStore := do.MustInvoke[interfaces.SessionStore](i)
 	configService := do.MustInvoke[interfaces.ConfigService](i)
+	errorService := do.MustInvoke[interfaces.ErrorService](i)
 	logger := do.MustInvoke[*zap.Logger](i)
 
 	return &authMiddleware{
 		authService:   authService,
+		sessionStore:  sessionStore,
 		configService: configService,
+		errorService:  errorService,
 		logger:        logger,
 	}, nil
 }
@@ -55,7 +64,7 @@ func (am *authMiddleware) Handle(next http.Handler, requirements *interfaces.Aut
 			am.logger.Error("Authentication error",
 				zap.String("path", r.URL.Path),
 				zap.Error(err))
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			am.renderError(w, r, http.StatusInternalServerError, err)
 			return
 		}
 
@@ -65,6 +74,16 @@ func (am *authMiddleware) Handle(next http.Handler, requirements *interfaces.Aut
 			return
 		}
 
+		// The session store extended the session, the cookie must expire with it
+		if authResult.Session != nil && authResult.Session.Renewed {
+			am.sessionStore.WriteSessionCookie(w, authResult.Session)
+		}
+
+		// The permission check and the handler see the authenticated user
+		if authResult.User != nil {
+			r = r.WithContext(context.WithValue(r.Context(), shared.UserContextKey, authResult.User))
+		}
+
 		// Check permissions
 		if !am.authService.HasRequiredPermissions(r, requirements) {
 			am.handlePermissionFailure(w, r, requirements)
@@ -96,21 +115,24 @@ func (am *authMiddleware) handleAuthFailure(w http.ResponseWriter, r *http.Reque
 
 	// Add return URL parameter so user can be redirected back after login
 	if redirectURL != "" {
-		if r.URL.RawQuery != "" {
-			redirectURL += "?return_to=" + r.URL.Path + "?" + r.URL.RawQuery
+		redirectURL = shared.JoinBasePath(shared.GetBasePath(r.Context()), redirectURL)
+
+		returnURL := returnToURL(r)
+		if returnURL.RawQuery != "" {
+			redirectURL += "?return_to=" + returnURL.Path + "?" + returnURL.RawQuery
 		} else {
-			redirectURL += "?return_to=" + r.URL.Path
+			redirectURL += "?return_to=" + returnURL.Path
 		}
 
 		am.logger.Info("Redirecting unauthenticated user to signin",
 			zap.String("original_path", r.URL.Path),
 			zap.String("redirect_url", redirectURL))
 
-		http.Redirect(w, r, redirectURL, http.StatusFound)
+		am.redirect(w, r, redirectURL)
 	} else {
 		am.logger.Warn("No signin route configured, falling back to error response",
 			zap.String("path", r.URL.Path))
-		http.Error(w, "Authentication required", http.StatusUnauthorized)
+		am.renderError(w, r, http.StatusUnauthorized, nil)
 	}
 }
 
@@ -121,11 +143,47 @@ func (am *authMiddleware) handlePermissionFailure(w http.ResponseWriter, r *http
 		zap.String("required_auth_type", requirements.Type.String()))
 
 	if requirements.RedirectURL != "" {
-		http.Redirect(w, r, requirements.RedirectURL, http.StatusFound)
+		am.redirect(w, r, shared.JoinBasePath(shared.GetBasePath(r.Context()), requirements.RedirectURL))
 	} else {
 		am.logger.Warn("Auth-required page has no redirect_url configured",
 			zap.String("path", r.URL.Path),
 			zap.String("auth_type", requirements.Type.String()))
-		http.Error(w, "Forbidden: Insufficient permissions", http.StatusForbidden)
+		am.renderError(w, r, http.StatusForbidden, nil)
+	}
+}
+
+// renderError renders the nearest error template for status (e.g. forbidden.templ)
+func (am *authMiddleware) renderError(w http.ResponseWriter, r *http.Request, status int, cause error) {
+	component := am.errorService.CreateErrorInfoComponent(NewRequestErrorInfo(r, status, cause), r.URL.Path)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := component.Render(r.Context(), w); err != nil {
+		am.logger.Error("Failed to render auth error page",
+			zap.Int("status", status),
+			zap.Error(err))
+	}
+}
+
+// redirect sends the client to redirectURL. HTMX requests get an HX-Redirect header instead
+// of a 302, which HTMX would follow transparently and swap into the target element.
+func (am *authMiddleware) redirect(w http.ResponseWriter, r *http.Request, redirectURL string) {
+	if shared.IsHTMXRequest(r) {
+		w.Header().Set(shared.HXRedirectHeader, redirectURL)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
+
+// returnToURL returns the URL the user should come back to after signing in.
+// For HTMX requests this is the page shown in the browser, not the fragment endpoint.
+func returnToURL(r *http.Request) *url.URL {
+	if shared.IsHTMXRequest(r) {
+		if current, err := url.Parse(r.Header.Get(shared.HXCurrentURLHeader)); err == nil && current.Path != "" {
+			return current
+		}
 	}
+	return r.URL
 }
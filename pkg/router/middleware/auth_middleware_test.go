@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// authTestService authenticates nobody
+type authTestService struct{}
+
+func (m *authTestService) Authenticate(req *http.Request, requirements *interfaces.AuthSettings) (*interfaces.AuthResult, error) {
+	return &interfaces.AuthResult{IsAuthenticated: false}, nil
+}
+
+func (m *authTestService) HasRequiredPermissions(req *http.Request, settings *interfaces.AuthSettings) bool {
+	return false
+}
+
+// authenticatedTestService authenticates everybody but grants no permissions
+type authenticatedTestService struct {
+	authTestService
+}
+
+func (m *authenticatedTestService) Authenticate(req *http.Request, requirements *interfaces.AuthSettings) (*interfaces.AuthResult, error) {
+	return &interfaces.AuthResult{IsAuthenticated: true}, nil
+}
+
+func TestAuthMiddlewareRedirectsHTMXRequestsWithHeader(t *testing.T) {
+	am := &authMiddleware{
+		authService:   &authTestService{},
+		configService: &mockRouterConfigService{},
+		logger:        zap.NewNop(),
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler must not be called for unauthenticated requests")
+	})
+	handler := am.Handle(next, &interfaces.AuthSettings{Type: interfaces.AuthTypeUser})
+
+	t.Run("Plain request", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/dashboard", nil))
+
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, "/login?return_to=/dashboard", rec.Header().Get("Location"))
+		assert.Empty(t, rec.Header().Get("HX-Redirect"))
+	})
+
+	t.Run("HTMX request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/dashboard?fragment=stats", nil)
+		req.Header.Set("HX-Request", "true")
+		req.Header.Set("HX-Current-URL", "http://example.com/dashboard?tab=2")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "/login?return_to=/dashboard?tab=2", rec.Header().Get("HX-Redirect"))
+		assert.Empty(t, rec.Header().Get("Location"))
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("HTMX permission failure", func(t *testing.T) {
+		permissionHandler := (&authMiddleware{
+			authService:   &authenticatedTestService{},
+			configService: &mockRouterConfigService{},
+			logger:        zap.NewNop(),
+		}).Handle(next, &interfaces.AuthSettings{Type: interfaces.AuthTypeAdmin, RedirectURL: "/forbidden"})
+
+		req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+		req.Header.Set("HX-Request", "true")
+		rec := httptest.NewRecorder()
+		permissionHandler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "/forbidden", rec.Header().Get("HX-Redirect"))
+	})
+}
+
+func TestAuthMiddlewareRendersForbiddenErrorTemplate(t *testing.T) {
+	errorService := &mockParamErrorService{}
+	am := &authMiddleware{
+		authService:   &authenticatedTestService{},
+		configService: &mockRouterConfigService{},
+		errorService:  errorService,
+		logger:        zap.NewNop(),
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler must not be called without permissions")
+	})
+	handler := am.Handle(next, &interfaces.AuthSettings{Type: interfaces.AuthTypeAdmin})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/users", nil))
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "error.templ: "+interfaces.ErrorMessageKeyForbidden, rec.Body.String())
+	assert.Equal(t, "/admin/users", errorService.lookupPath)
+	assert.Equal(t, http.StatusForbidden, errorService.info.StatusCode)
+}
+
+// authTestUser is the user of renewedSessionTestService
+type authTestUser struct{}
+
+func (u *authTestUser) GetID() string      { return "bob" }
+func (u *authTestUser) GetEmail() string   { return "bob@example.com" }
+func (u *authTestUser) GetRoles() []string { return []string{"user"} }
+
+// renewedSessionTestService authenticates with a renewed session and grants permissions to the user of the context
+type renewedSessionTestService struct {
+	authTestService
+}
+
+func (m *renewedSessionTestService) Authenticate(req *http.Request, requirements *interfaces.AuthSettings) (*interfaces.AuthResult, error) {
+	return &interfaces.AuthResult{
+		IsAuthenticated: true,
+		User:            &authTestUser{},
+		Session:         &interfaces.Session{ID: "bob", Valid: true, Renewed: true},
+	}, nil
+}
+
+func (m *renewedSessionTestService) HasRequiredPermissions(req *http.Request, settings *interfaces.AuthSettings) bool {
+	return req.Context().Value(shared.UserContextKey) != nil
+}
+
+func TestAuthMiddlewareWritesRenewedSessionCookie(t *testing.T) {
+	sessionStore := &rateLimitTestSessionStore{}
+	am := &authMiddleware{
+		authService:   &renewedSessionTestService{},
+		sessionStore:  sessionStore,
+		configService: &mockRouterConfigService{},
+		logger:        zap.NewNop(),
+	}
+	handler := am.Handle(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), &interfaces.AuthSettings{Type: interfaces.AuthTypeUser})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/dashboard", nil))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, []string{"bob"}, sessionStore.written)
+}
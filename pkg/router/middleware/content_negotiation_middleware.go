@@ -0,0 +1,184 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/adapter"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+// contentNegotiationMiddleware serves the DataService result of a page as JSON (private implementation)
+type contentNegotiationMiddleware struct {
+	dataResolver  interfaces.DataServiceResolver
+	errorService  interfaces.ErrorService
+	configService interfaces.ConfigService
+	logger        *zap.Logger
+}
+
+// NewContentNegotiationMiddleware creates a new content negotiation middleware for DI
+func NewContentNegotiationMiddleware(i do.Injector) (ContentNegotiationMiddlewareInterface, error) {
+	dataResolver := do.MustInvoke[interfaces.DataServiceResolver](i)
+	errorService := do.MustInvoke[interfaces.ErrorService](i)
+	configService := do.MustInvoke[interfaces.ConfigService](i)
+	logger := do.MustInvoke[*zap.Logger](i)
+
+	return &contentNegotiationMiddleware{
+		dataResolver:  dataResolver,
+		errorService:  errorService,
+		configService: configService,
+		logger:        logger,
+	}, nil
+}
+
+// ServeJSONSuffix dispatches a "<path>.json" request again for path and marks it as a JSON request.
+// path includes the mount prefix, like the request URL.
+func ServeJSONSuffix(w http.ResponseWriter, r *http.Request, path string) {
+	ctx := context.WithValue(r.Context(), shared.JSONSuffixKey, true)
+	path = strings.TrimPrefix(path, shared.GetBasePath(r.Context()))
+	adapter.FromRequest(r).Redispatch(w, r.WithContext(ctx), path)
+}
+
+// Handle serves the DataService result as JSON when the request asks for it and the page
+// opted in with "api: { json: true }". Other requests render the page.
+func (cnm *contentNegotiationMiddleware) Handle(next http.Handler, route interfaces.Route, settings *interfaces.APISettings) http.Handler {
+	if !cnm.jsonEnabled(route, settings) {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The .json path of a page without JSON API does not exist
+			if shared.IsJSONSuffixRequest(r) {
+				cnm.renderNotFound(w, r, route)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The representation depends on the Accept header, so shared caches must keep them apart
+		w.Header().Add("Vary", "Accept")
+
+		// Form submissions are answered by the page action
+		if !shared.WantsJSON(r) || (r.Method != http.MethodGet && r.Method != http.MethodHead) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cnm.serveJSON(w, r, route)
+	})
+}
+
+// HandleSuffix serves "<path>.json" requests that the trailing parameter of the route matched,
+// e.g. /users/42.json on /users/$id, as JSON requests for <path>. The suffix is removed before
+// any other middleware sees the parameter.
+func (cnm *contentNegotiationMiddleware) HandleSuffix(next http.Handler, route interfaces.Route, settings *interfaces.APISettings) http.Handler {
+	if !cnm.jsonEnabled(route, settings) || !hasTrailingParam(route.Path) {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, ok := shared.TrimJSONSuffix(r.URL.Path)
+		if !ok || shared.IsJSONSuffixRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ServeJSONSuffix(w, r, path)
+	})
+}
+
+// jsonEnabled checks if the page serves its DataService result as JSON
+func (cnm *contentNegotiationMiddleware) jsonEnabled(route interfaces.Route, settings *interfaces.APISettings) bool {
+	if settings == nil || !settings.JSON {
+		return false
+	}
+
+	if !route.RequiresDataService {
+		cnm.logger.Warn("JSON API enabled on a page without DataService, ignoring it",
+			zap.String("route", route.Path),
+			zap.String("template", route.TemplateFile))
+		return false
+	}
+
+	return true
+}
+
+// serveJSON resolves the DataService of the route and writes its result as JSON
+func (cnm *contentNegotiationMiddleware) serveJSON(w http.ResponseWriter, r *http.Request, route interfaces.Route) {
+	service, err := cnm.dataResolver.ResolveGenericDataService(route.DataServiceInterface)
+	if err != nil {
+		cnm.writeJSONError(w, r, route, http.StatusInternalServerError,
+			shared.NewDependencyInjectionError("failed to resolve generic data service").
+				WithCause(err).
+				WithContext("interface_type", route.DataServiceInterface).
+				WithContext("route", route.Path))
+		return
+	}
+
+	data, err := service.GetData(NewRouterContext(r.Context(), r))
+	if err != nil {
+		cnm.writeJSONError(w, r, route, http.StatusInternalServerError, err)
+		return
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		cnm.writeJSONError(w, r, route, http.StatusInternalServerError,
+			shared.NewServiceError("failed to encode data service result").
+				WithCause(err).
+				WithContext("interface_type", route.DataServiceInterface).
+				WithContext("route", route.Path))
+		return
+	}
+
+	w.Header().Set("Content-Type", shared.JSONContentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// writeJSONError writes the error info of a failed JSON request
+func (cnm *contentNegotiationMiddleware) writeJSONError(w http.ResponseWriter, r *http.Request, route interfaces.Route, status int, cause error) {
+	cnm.logger.Error("JSON rendering failed",
+		zap.String("route", route.Path),
+		zap.String("data_service_interface", route.DataServiceInterface),
+		zap.Error(cause))
+
+	info := NewRequestErrorInfo(r, status, cause)
+	info.Message = interfaces.DefaultErrorMessage(info.MessageKey)
+
+	w.Header().Set("Content-Type", shared.JSONContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(info); err != nil {
+		cnm.logger.Error("Failed to write JSON error",
+			zap.String("route", route.Path),
+			zap.Error(err))
+	}
+}
+
+// renderNotFound renders the nearest not-found template of the route
+func (cnm *contentNegotiationMiddleware) renderNotFound(w http.ResponseWriter, r *http.Request, route interfaces.Route) {
+	info := NewRequestErrorInfo(r, http.StatusNotFound, nil)
+	component := cnm.errorService.CreateErrorInfoComponent(info, ErrorLookupPath(cnm.configService, route.TemplateFile))
+	if component == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusNotFound)
+	if err := component.Render(r.Context(), w); err != nil {
+		cnm.logger.Error("Failed to render not found page",
+			zap.String("route", route.Path),
+			zap.Error(err))
+	}
+}
+
+// hasTrailingParam checks if the last segment of a route pattern is a parameter or catch-all
+func hasTrailingParam(pattern string) bool {
+	_, ok := shared.ParseRouteParamSegment(pattern[strings.LastIndex(pattern, "/")+1:])
+	return ok
+}
@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/adapter"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testUserData is the result of testUserDataService
+type testUserData struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// testUserDataService returns the user of the id parameter
+type testUserDataService struct {
+	err error
+}
+
+func (s *testUserDataService) GetData(routerCtx interfaces.RouterContext) (interface{}, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &testUserData{ID: routerCtx.GetURLParam("id"), Name: "Jane"}, nil
+}
+
+// testDataServiceResolver resolves every interface to the same service
+type testDataServiceResolver struct {
+	service interfaces.GenericDataService
+}
+
+func (r *testDataServiceResolver) ResolveDataService(interfaceType string) (interface{}, error) {
+	return r.service, nil
+}
+
+func (r *testDataServiceResolver) ResolveGenericDataService(interfaceType string) (interfaces.GenericDataService, error) {
+	return r.service, nil
+}
+
+func (r *testDataServiceResolver) HasDataService(interfaceType string) bool {
+	return true
+}
+
+// newContentNegotiationTestRouter serves /users/{id} with JSON API and /about without,
+// redispatching .json requests without route like the route registrar does
+func newContentNegotiationTestRouter(service interfaces.GenericDataService) http.Handler {
+	cnm := &contentNegotiationMiddleware{
+		dataResolver:  &testDataServiceResolver{service: service},
+		errorService:  &mockParamErrorService{},
+		configService: &mockRouterConfigService{},
+		logger:        zap.NewNop(),
+	}
+
+	page := func(body string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(body))
+		})
+	}
+
+	settings := &interfaces.APISettings{JSON: true}
+	users := interfaces.Route{
+		Path:                 "/users/$id",
+		TemplateFile:         "app/users/id_/page.templ",
+		RequiresDataService:  true,
+		DataServiceInterface: "UserDataService",
+	}
+	about := interfaces.Route{Path: "/about", TemplateFile: "app/about/page.templ"}
+
+	routerAdapter := adapter.NewChiAdapter(chi.NewRouter())
+	routerAdapter.Handle(http.MethodGet, "/users/{id}",
+		cnm.HandleSuffix(cnm.Handle(page("users page"), users, settings), users, settings))
+	routerAdapter.Handle(http.MethodGet, "/about", cnm.HandleSuffix(cnm.Handle(page("about page"), about, nil), about, nil))
+	routerAdapter.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		if path, ok := shared.TrimJSONSuffix(r.URL.Path); ok && !shared.IsJSONSuffixRequest(r) {
+			ServeJSONSuffix(w, r, path)
+			return
+		}
+		http.NotFound(w, r)
+	})
+
+	return routerAdapter
+}
+
+func TestContentNegotiationMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		accept string
+		status int
+		json   bool
+		body   string
+	}{
+		{name: "Browser gets the page", target: "/users/42", accept: "text/html", status: http.StatusOK, body: "users page"},
+		{name: "Accept header selects JSON", target: "/users/42", accept: "application/json", status: http.StatusOK, json: true},
+		{name: "Format query selects JSON", target: "/users/42?format=json", status: http.StatusOK, json: true},
+		{name: "Suffix on the trailing parameter selects JSON", target: "/users/42.json", status: http.StatusOK, json: true},
+		{name: "Page without JSON API ignores Accept", target: "/about", accept: "application/json", status: http.StatusOK, body: "about page"},
+		{name: "Suffix of a page without JSON API is not found", target: "/about.json", status: http.StatusNotFound, body: "error.templ: error_not_found"},
+	}
+
+	handler := newContentNegotiationTestRouter(&testUserDataService{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, r)
+
+			assert.Equal(t, tt.status, rec.Code)
+			if !tt.json {
+				assert.Equal(t, tt.body, rec.Body.String())
+				return
+			}
+
+			assert.Equal(t, shared.JSONContentType, rec.Header().Get("Content-Type"))
+			assert.Contains(t, rec.Header().Values("Vary"), "Accept")
+
+			var data testUserData
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &data))
+			assert.Equal(t, testUserData{ID: "42", Name: "Jane"}, data)
+		})
+	}
+}
+
+func TestContentNegotiationMiddlewareDataServiceError(t *testing.T) {
+	handler := newContentNegotiationTestRouter(&testUserDataService{err: errors.New("database down")})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/42.json", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, shared.JSONContentType, rec.Header().Get("Content-Type"))
+
+	var info interfaces.ErrorInfo
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &info))
+	assert.Equal(t, http.StatusInternalServerError, info.StatusCode)
+	assert.Equal(t, interfaces.ErrorMessageKeyInternal, info.MessageKey)
+	assert.Equal(t, "/users/42", info.Path)
+	assert.NotContains(t, rec.Body.String(), "database down")
+}
@@ -0,0 +1,199 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+// csrfTokenBytes is the size of the random part of a CSRF token
+const csrfTokenBytes = 32
+
+var (
+	errCSRFCookieMissing = errors.New("CSRF cookie missing or not signed with the CSRF secret")
+	errCSRFTokenMismatch = errors.New("CSRF token missing or not matching the CSRF cookie")
+)
+
+// csrfMiddleware protects state-changing requests with signed double-submit tokens (private implementation)
+type csrfMiddleware struct {
+	configService interfaces.ConfigService
+	errorService  interfaces.ErrorService
+	i18nService   interfaces.I18nService
+	logger        *zap.Logger
+}
+
+// NewCSRFMiddleware creates a new CSRF middleware for DI
+func NewCSRFMiddleware(i do.Injector) (CSRFMiddlewareInterface, error) {
+	configService := do.MustInvoke[interfaces.ConfigService](i)
+	errorService := do.MustInvoke[interfaces.ErrorService](i)
+	i18nService := do.MustInvoke[interfaces.I18nService](i)
+	logger := do.MustInvoke[*zap.Logger](i)
+
+	return &csrfMiddleware{
+		configService: configService,
+		errorService:  errorService,
+		i18nService:   i18nService,
+		logger:        logger,
+	}, nil
+}
+
+// Handle issues the CSRF cookie and stores its token in the request context for the templ helpers.
+// Requests with other methods than GET, HEAD, OPTIONS and TRACE must repeat the token in the
+// X-CSRF-Token header or the csrf_token form field, or they are answered with 403. Paths below
+// TR_SECURITY_CSRF_EXEMPT_PATHS, e.g. webhooks of route.go handlers, are not checked.
+func (cm *csrfMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookieToken := cm.cookieToken(r)
+
+		token := cookieToken
+		if token == "" {
+			var err error
+			if token, err = cm.newToken(); err != nil {
+				cm.logger.Error("Failed to create CSRF token", zap.Error(err))
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			http.SetCookie(w, cm.newCookie(token))
+		}
+
+		// The token is also in the context of a rejected request, so its error page can retry
+		r = r.WithContext(context.WithValue(r.Context(), shared.CSRFTokenKey, token))
+
+		if !shared.IsSafeMethod(r.Method) && !cm.isExempt(r.URL.Path) {
+			if err := cm.verify(r, cookieToken); err != nil {
+				cm.logger.Warn("CSRF check failed",
+					zap.String("path", r.URL.Path),
+					zap.String("method", r.Method),
+					zap.Error(err))
+
+				cm.renderForbidden(w, r, err)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isExempt checks if path is below one of the exempt path prefixes. The middleware wraps the
+// router, so the prefixes are joined with the base path of the router.
+func (cm *csrfMiddleware) isExempt(path string) bool {
+	basePath := cm.configService.GetRouterBasePath()
+	for _, prefix := range cm.configService.GetCSRFExemptPaths() {
+		prefix = shared.NormalizeBasePath(prefix)
+		if prefix == "" {
+			continue
+		}
+		prefix = shared.JoinBasePath(basePath, prefix)
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// verify compares the submitted token with the token of the request cookie
+func (cm *csrfMiddleware) verify(r *http.Request, cookieToken string) error {
+	if cookieToken == "" {
+		return errCSRFCookieMissing
+	}
+
+	submitted := r.Header.Get(shared.CSRFHeaderName)
+	if submitted == "" {
+		submitted = r.PostFormValue(shared.CSRFFieldName)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(submitted), []byte(cookieToken)) != 1 {
+		return errCSRFTokenMismatch
+	}
+	return nil
+}
+
+// cookieToken returns the token of the CSRF cookie if it was signed with the CSRF secret
+func (cm *csrfMiddleware) cookieToken(r *http.Request) string {
+	cookie, err := r.Cookie(shared.CSRFCookieName)
+	if err != nil {
+		return ""
+	}
+
+	nonce, signature, ok := strings.Cut(cookie.Value, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(cm.sign(nonce))) {
+		return ""
+	}
+	return cookie.Value
+}
+
+// newToken creates a random token signed with the CSRF secret, "<nonce>.<signature>"
+func (cm *csrfMiddleware) newToken() (string, error) {
+	nonce := make([]byte, csrfTokenBytes)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(nonce)
+	return encoded + "." + cm.sign(encoded), nil
+}
+
+// sign returns the HMAC-SHA256 of nonce keyed with the CSRF secret
+func (cm *csrfMiddleware) sign(nonce string) string {
+	mac := hmac.New(sha256.New, []byte(cm.configService.GetCSRFSecret()))
+	mac.Write([]byte(nonce))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// newCookie creates the CSRF cookie with the configured security attributes,
+// scoped to the base path of the router
+func (cm *csrfMiddleware) newCookie(token string) *http.Cookie {
+	cookiePath := cm.configService.GetRouterBasePath()
+	if cookiePath == "" {
+		cookiePath = "/"
+	}
+
+	return &http.Cookie{
+		Name:     shared.CSRFCookieName,
+		Value:    token,
+		Path:     cookiePath,
+		Secure:   cm.configService.IsCSRFSecure(),
+		HttpOnly: cm.configService.IsCSRFHttpOnly(),
+		SameSite: shared.ParseSameSite(cm.configService.GetCSRFSameSite()),
+	}
+}
+
+// renderForbidden renders the nearest 403 error template of the request path.
+// The request did not pass the route middleware, so locale and translations are set up here.
+func (cm *csrfMiddleware) renderForbidden(w http.ResponseWriter, r *http.Request, cause error) {
+	info := NewRequestErrorInfo(r, http.StatusForbidden, cause)
+	component := cm.errorService.CreateErrorInfoComponent(info, r.URL.Path)
+	if component == nil {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	ctx := r.Context()
+	if locale := cm.i18nService.ExtractLocale(r); locale != "" {
+		ctx = context.WithValue(ctx, shared.LocaleKey, locale)
+	}
+	rootLayout := filepath.Join(cm.configService.GetLayoutRootDirectory(),
+		cm.configService.GetLayoutFileName()+cm.configService.GetTemplateExtension())
+	ctx = cm.i18nService.CreateContext(ctx, rootLayout)
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusForbidden)
+	if err := component.Render(ctx, w); err != nil {
+		cm.logger.Error("Failed to render CSRF error page",
+			zap.String("path", r.URL.Path),
+			zap.Error(err))
+	}
+}
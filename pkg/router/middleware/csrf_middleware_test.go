@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// serveWithCSRF serves r through the CSRF middleware and returns the token the handler saw
+func serveWithCSRF(r *http.Request) (*httptest.ResponseRecorder, string, *recoveryTestI18nService) {
+	return serveWithCSRFConfig(r, &mockRouterConfigService{})
+}
+
+// serveWithCSRFConfig serves r through a CSRF middleware with configService
+func serveWithCSRFConfig(r *http.Request, configService *mockRouterConfigService) (*httptest.ResponseRecorder, string, *recoveryTestI18nService) {
+	i18nService := &recoveryTestI18nService{}
+	cm := &csrfMiddleware{
+		configService: configService,
+		errorService:  &mockParamErrorService{},
+		i18nService:   i18nService,
+		logger:        zap.NewNop(),
+	}
+
+	var token string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Context().Value(shared.CSRFTokenKey).(string)
+		w.Write([]byte("ok"))
+	})
+
+	rec := httptest.NewRecorder()
+	cm.Handle(handler).ServeHTTP(rec, r)
+	return rec, token, i18nService
+}
+
+// issueCSRFCookie runs a GET request and returns the CSRF cookie it received
+func issueCSRFCookie(t *testing.T) *http.Cookie {
+	rec, token, _ := serveWithCSRF(httptest.NewRequest(http.MethodGet, "/login", nil))
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, token, cookies[0].Value)
+	return cookies[0]
+}
+
+func TestCSRFMiddlewareIssuesCookieOnSafeRequests(t *testing.T) {
+	rec, token, _ := serveWithCSRF(httptest.NewRequest(http.MethodGet, "/login", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEmpty(t, token)
+
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, shared.CSRFCookieName, cookies[0].Name)
+	assert.Equal(t, token, cookies[0].Value)
+	assert.Equal(t, "/", cookies[0].Path)
+	assert.True(t, cookies[0].HttpOnly)
+	assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+
+	// A valid cookie is kept
+	r := httptest.NewRequest(http.MethodGet, "/login", nil)
+	r.AddCookie(cookies[0])
+	rec, reused, _ := serveWithCSRF(r)
+	assert.Equal(t, token, reused)
+	assert.Empty(t, rec.Result().Cookies())
+}
+
+func TestCSRFMiddlewareAcceptsHeaderAndFormToken(t *testing.T) {
+	cookie := issueCSRFCookie(t)
+
+	r := httptest.NewRequest(http.MethodPost, "/api/auth/signout", nil)
+	r.AddCookie(cookie)
+	r.Header.Set(shared.CSRFHeaderName, cookie.Value)
+	rec, _, _ := serveWithCSRF(r)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	r = newFormRequest(http.MethodPost, url.Values{shared.CSRFFieldName: {cookie.Value}})
+	r.AddCookie(cookie)
+	rec, _, _ = serveWithCSRF(r)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestCSRFMiddlewareRejectsMissingToken(t *testing.T) {
+	cookie := issueCSRFCookie(t)
+
+	r := newFormRequest(http.MethodPost, url.Values{"email": {"jane@example.com"}})
+	r.AddCookie(cookie)
+	rec, _, i18nService := serveWithCSRF(r)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "error.templ: error_forbidden: "+errCSRFTokenMismatch.Error(), rec.Body.String())
+	assert.Equal(t, "app/layout.templ", i18nService.templatePath)
+}
+
+func TestCSRFMiddlewareRejectsForgedCookie(t *testing.T) {
+	forged := &http.Cookie{Name: shared.CSRFCookieName, Value: "attacker.token"}
+
+	r := httptest.NewRequest(http.MethodDelete, "/api/items/1", nil)
+	r.AddCookie(forged)
+	r.Header.Set(shared.CSRFHeaderName, forged.Value)
+	rec, token, _ := serveWithCSRF(r)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "error.templ: error_forbidden: "+errCSRFCookieMissing.Error(), rec.Body.String())
+	assert.Empty(t, token, "the handler must not run")
+
+	// The rejected request still receives a valid cookie to retry with
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.NotEqual(t, forged.Value, cookies[0].Value)
+}
+
+func TestCSRFMiddlewareScopesCookieToBasePath(t *testing.T) {
+	rec, _, _ := serveWithCSRFConfig(httptest.NewRequest(http.MethodGet, "/portal/login", nil),
+		&mockRouterConfigService{basePath: "/portal"})
+
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "/portal", cookies[0].Path)
+}
+
+func TestCSRFMiddlewareExemptPaths(t *testing.T) {
+	configService := &mockRouterConfigService{basePath: "/portal", csrfExemptPaths: []string{"webhooks/", "/api/hooks"}}
+
+	tests := []struct {
+		path         string
+		expectedCode int
+	}{
+		{"/portal/webhooks", http.StatusOK},
+		{"/portal/webhooks/payments", http.StatusOK},
+		{"/portal/api/hooks/github", http.StatusOK},
+		{"/portal/webhooks-admin", http.StatusForbidden},
+		{"/webhooks/payments", http.StatusForbidden},
+		{"/portal/api/auth/signout", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec, _, _ := serveWithCSRFConfig(httptest.NewRequest(http.MethodPost, tt.path, nil), configService)
+			assert.Equal(t, tt.expectedCode, rec.Code)
+		})
+	}
+}
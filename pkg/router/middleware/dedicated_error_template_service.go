@@ -6,6 +6,7 @@ import (
 
 	"github.com/a-h/templ"
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -62,9 +63,13 @@ func (dets *dedicatedErrorTemplateServiceImpl) RenderErrorTemplate(errorTemplate
 // generateErrorTemplateKey generates a template key for error templates
 // This follows the same pattern as regular templates but for error.templ files
 func (dets *dedicatedErrorTemplateServiceImpl) generateErrorTemplateKey(errorTemplate *interfaces.ErrorTemplate) string {
-	// Convert file path to template key
-	// Example: "app/admin/error.templ" -> "app/admin/error.templ#Error"
-	return fmt.Sprintf("%s#Error", errorTemplate.FilePath)
+	// Convert file path to template key like the template generator
+	// Example: "app/admin/error.templ" -> GenerateTemplateKey("app/admin/error.templ#Error")
+	componentName := errorTemplate.ComponentName
+	if componentName == "" {
+		componentName = "Error"
+	}
+	return shared.GenerateTemplateKey(fmt.Sprintf("%s#%s", errorTemplate.FilePath, componentName))
 }
 
 // executeErrorTemplateFunction executes error template function with proper error context
@@ -85,6 +90,27 @@ func (dets *dedicatedErrorTemplateServiceImpl) executeErrorTemplateFunction(temp
 		return component, nil
 	}
 
+	if fn, ok := result.(func(ErrorContext) templ.Component); ok {
+		component := fn(*errorContext)
+		dets.logger.Debug("Error template with context executed successfully")
+		return component, nil
+	}
+
+	// Handle error template with error info parameter
+	if errorContext.Info != nil {
+		if fn, ok := result.(func(*interfaces.ErrorInfo) templ.Component); ok {
+			component := fn(errorContext.Info)
+			dets.logger.Debug("Error template with error info executed successfully")
+			return component, nil
+		}
+
+		if fn, ok := result.(func(interfaces.ErrorInfo) templ.Component); ok {
+			component := fn(*errorContext.Info)
+			dets.logger.Debug("Error template with error info executed successfully")
+			return component, nil
+		}
+	}
+
 	// Handle generic interface{} parameter (fallback)
 	if fn, ok := result.(func(interface{}) templ.Component); ok {
 		component := fn(errorContext)
@@ -110,7 +136,11 @@ func (dets *dedicatedErrorTemplateServiceImpl) executeErrorTemplateWithReflectio
 
 	if funcType.NumIn() == 1 {
 		// Single parameter - pass error context
-		args = []reflect.Value{reflect.ValueOf(errorContext)}
+		arg := reflect.ValueOf(errorContext)
+		if !arg.Type().AssignableTo(funcType.In(0)) {
+			return nil, fmt.Errorf("unsupported error template parameter type: %s", funcType.In(0))
+		}
+		args = []reflect.Value{arg}
 	} else if funcType.NumIn() == 0 {
 		// No parameters
 		args = []reflect.Value{}
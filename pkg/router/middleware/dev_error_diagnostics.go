@@ -0,0 +1,210 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"gopkg.in/yaml.v2"
+)
+
+// devErrorTemplates renders the development error overlay and the standalone error page
+var devErrorTemplates = template.Must(template.ParseFS(errorTemplates, "templates/dev_error.html"))
+
+// devErrorDiagnostics is the request context shown by the development error overlay.
+// It may reveal internals and must only be rendered when the config service reports development.
+type devErrorDiagnostics struct {
+	StatusCode           int
+	Message              string
+	Method               string
+	Path                 string
+	RequestID            string
+	Route                string
+	TemplateFile         string
+	Params               map[string]string
+	DataServiceInterface string
+	ErrorChain           []devErrorChainEntry
+	Config               string
+	LayoutChain          []string
+	Stack                string
+}
+
+// devErrorChainEntry is one error of the unwrapped cause chain
+type devErrorChainEntry struct {
+	Message string
+	Type    string
+	Code    string
+	Details string
+	Context map[string]string
+}
+
+// newDevErrorDiagnostics collects the diagnostics of a failed request. The page metadata is
+// merged with the metadata of the layout chain, as the layouts see it while rendering.
+func newDevErrorDiagnostics(config interfaces.ConfigService, r *http.Request, route interfaces.Route, status int, cause error, layout *interfaces.LayoutTemplate) *devErrorDiagnostics {
+	diagnostics := &devErrorDiagnostics{
+		StatusCode:           status,
+		Message:              http.StatusText(status),
+		Method:               r.Method,
+		Path:                 r.URL.Path,
+		RequestID:            requestID(r),
+		Route:                route.Path,
+		TemplateFile:         route.TemplateFile,
+		Params:               NewRouterContext(r.Context(), r).GetAllURLParams(),
+		DataServiceInterface: route.DataServiceInterface,
+		ErrorChain:           devErrorChain(cause),
+	}
+
+	var metadata *shared.ConfigFile
+	if route.TemplateFile != "" {
+		// page.templ -> page.templ.yaml with the default extensions
+		metadataPath := strings.TrimSuffix(route.TemplateFile, config.GetTemplateExtension()) + config.GetMetadataExtension()
+		if _, templateConfig, err := shared.ParseYAMLMetadata(metadataPath); err == nil {
+			metadata = templateConfig
+		}
+	}
+
+	if layout != nil {
+		for _, chainLayout := range layout.Chain() {
+			diagnostics.LayoutChain = append(diagnostics.LayoutChain, chainLayout.FilePath)
+			metadata = mergeLayoutConfig(chainLayout, metadata)
+		}
+	}
+	diagnostics.Config = devConfigYAML(metadata)
+
+	return diagnostics
+}
+
+// devErrorChain unwraps cause into its chain; AppErrors contribute their type, code and context
+func devErrorChain(cause error) []devErrorChainEntry {
+	var chain []devErrorChainEntry
+	for err := cause; err != nil; err = errors.Unwrap(err) {
+		entry := devErrorChainEntry{Message: err.Error()}
+
+		if appErr, ok := err.(*shared.AppError); ok {
+			entry.Type = string(appErr.Type)
+			entry.Code = appErr.Code
+			entry.Message = appErr.Message
+			entry.Details = appErr.Details
+			if len(appErr.Context) > 0 {
+				entry.Context = make(map[string]string, len(appErr.Context))
+				for key, value := range appErr.Context {
+					entry.Context[key] = fmt.Sprint(value)
+				}
+			}
+		}
+
+		chain = append(chain, entry)
+	}
+	return chain
+}
+
+// mergeLayoutConfig merges the metadata of layout below config, so config keeps precedence
+func mergeLayoutConfig(layout *interfaces.LayoutTemplate, config *shared.ConfigFile) *shared.ConfigFile {
+	if layout.YamlPath == "" {
+		return config
+	}
+
+	_, layoutConfig, err := shared.ParseYAMLMetadata(layout.YamlPath)
+	if err != nil {
+		return config
+	}
+	if config == nil {
+		return layoutConfig
+	}
+	return mergeConfigs(layoutConfig, config)
+}
+
+// devConfigYAML renders the non-empty sections of config as YAML
+func devConfigYAML(config *shared.ConfigFile) string {
+	if config == nil {
+		return ""
+	}
+
+	sections := map[string]interface{}{}
+	addSection := func(key string, value interface{}) {
+		switch v := value.(type) {
+		case nil:
+			return
+		case map[string]string:
+			if len(v) == 0 {
+				return
+			}
+		case map[string]map[string]string:
+			if len(v) == 0 {
+				return
+			}
+		}
+		sections[key] = value
+	}
+
+	addSection("metadata", config.RouteMetadata)
+	addSection("i18n", config.MultiLocaleI18n)
+	if len(config.MultiLocaleI18n) == 0 {
+		addSection("i18n", config.I18nMappings)
+	}
+	addSection("auth", config.AuthSettings)
+	addSection("layout", config.LayoutSettings)
+	addSection("error", config.ErrorSettings)
+	addSection("dynamic", config.DynamicSettings)
+	addSection("api", config.APISettings)
+	addSection("rate_limit", config.RateLimitSettings)
+	addSection("security", config.SecuritySettings)
+
+	if len(sections) == 0 {
+		return ""
+	}
+
+	out, err := yaml.Marshal(sections)
+	if err != nil {
+		return fmt.Sprintf("failed to render config: %v", err)
+	}
+	return string(out)
+}
+
+// devErrorOverlay renders the diagnostics on top of the page rendered before it
+func devErrorOverlay(diagnostics *devErrorDiagnostics) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return devErrorTemplates.ExecuteTemplate(w, "overlay", diagnostics)
+	})
+}
+
+// withDevErrorOverlay renders component with the diagnostics overlay injected before its
+// closing body tag, documents without one get the overlay appended
+func withDevErrorOverlay(component templ.Component, diagnostics *devErrorDiagnostics) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		var page bytes.Buffer
+		if err := component.Render(ctx, &page); err != nil {
+			return err
+		}
+
+		html := page.Bytes()
+		bodyEnd := bytes.LastIndex(bytes.ToLower(html), []byte("</body>"))
+		if bodyEnd < 0 {
+			bodyEnd = len(html)
+		}
+
+		if _, err := w.Write(html[:bodyEnd]); err != nil {
+			return err
+		}
+		if err := devErrorOverlay(diagnostics).Render(ctx, w); err != nil {
+			return err
+		}
+		_, err := w.Write(html[bodyEnd:])
+		return err
+	})
+}
+
+// devErrorPage renders the diagnostics as a standalone HTML document
+func devErrorPage(diagnostics *devErrorDiagnostics) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return devErrorTemplates.ExecuteTemplate(w, "page", diagnostics)
+	})
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// RequestIDHeader is read when no request ID middleware assigned one
+const RequestIDHeader = "X-Request-ID"
+
+// NewRequestErrorInfo creates the error info for a failed request.
+// The cause is dropped by the error service outside development.
+func NewRequestErrorInfo(r *http.Request, status int, cause error) *interfaces.ErrorInfo {
+	info := interfaces.NewErrorInfo(status, r.URL.Path)
+	info.RequestID = requestID(r)
+	info.Cause = cause
+	return info
+}
+
+// requestID returns the ID assigned by chi's RequestID middleware or the X-Request-ID header
+func requestID(r *http.Request) string {
+	if id := chimiddleware.GetReqID(r.Context()); id != "" {
+		return id
+	}
+	return r.Header.Get(RequestIDHeader)
+}
+
+// ErrorLookupPath returns the template directory relative to the template root,
+// so error template resolution starts next to the page template
+// e.g., app/locale_/data/userId_/page.templ -> /locale_/data/userId_
+func ErrorLookupPath(config interfaces.ConfigService, templateFile string) string {
+	dir := filepath.ToSlash(filepath.Dir(templateFile))
+	rootDir := strings.Trim(filepath.ToSlash(config.GetLayoutRootDirectory()), "/")
+	dir = strings.TrimPrefix(strings.TrimPrefix(dir, "/"), rootDir)
+	return "/" + strings.Trim(dir, "/")
+}
@@ -1,8 +1,14 @@
 package middleware
 
 import (
+	"context"
+	"io"
+
 	"github.com/a-h/templ"
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/i18n"
+	"github.com/denkhaus/templ-router/pkg/router/templates"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -15,6 +21,7 @@ type ErrorServiceCore struct {
 	renderer              *ErrorRenderer
 	templateService       interfaces.TemplateService // Integration with OptimizedTemplateService
 	dedicatedErrorService DedicatedErrorTemplateService // NEW: Dedicated error template service
+	configService         interfaces.ConfigService
 	logger                *zap.Logger
 }
 
@@ -27,6 +34,7 @@ func NewErrorServiceCore(i do.Injector) (interfaces.ErrorService, error) {
 
 	renderer := NewErrorRenderer()
 	templateService := do.MustInvoke[interfaces.TemplateService](i)
+	configService := do.MustInvoke[interfaces.ConfigService](i)
 	logger := do.MustInvoke[*zap.Logger](i)
 
 	// NEW: Invoke dedicated error template service from DI
@@ -37,6 +45,7 @@ func NewErrorServiceCore(i do.Injector) (interfaces.ErrorService, error) {
 		renderer:              renderer,
 		templateService:       templateService,
 		dedicatedErrorService: dedicatedErrorService,
+		configService:         configService,
 		logger:                logger,
 	}, nil
 }
@@ -48,42 +57,95 @@ func (esc *ErrorServiceCore) FindErrorTemplateForPath(path string) *interfaces.E
 
 // CreateErrorComponent creates an error component with proper template resolution
 func (esc *ErrorServiceCore) CreateErrorComponent(message, path string) templ.Component {
+	return esc.CreateErrorInfoComponent(&interfaces.ErrorInfo{
+		StatusCode: 500,
+		MessageKey: interfaces.ErrorMessageKeyInternal,
+		Message:    message,
+		Path:       path,
+	}, path)
+}
+
+// CreateErrorInfoComponent creates an error component for the status of info.
+// The message is localized and the info is put into the context when the component renders.
+func (esc *ErrorServiceCore) CreateErrorInfoComponent(info *interfaces.ErrorInfo, lookupPath string) templ.Component {
 	esc.logger.Debug("Creating error component",
-		zap.String("message", message),
-		zap.String("path", path))
+		zap.Int("status", info.StatusCode),
+		zap.String("message_key", info.MessageKey),
+		zap.String("path", lookupPath))
+
+	// Work on a copy; causes may reveal internals and are only kept in development
+	errorInfo := *info
+	if errorInfo.StatusCode == 0 {
+		errorInfo.StatusCode = 500
+	}
+	if errorInfo.MessageKey == "" {
+		errorInfo.MessageKey = interfaces.ErrorMessageKeyForStatus(errorInfo.StatusCode)
+	}
+	if esc.configService == nil || !esc.configService.IsDevelopment() {
+		errorInfo.Cause = nil
+	}
 
 	// Try to find a specific error template first
-	errorTemplate := esc.templateResolver.FindErrorTemplateForPath(path)
-
+	errorTemplate := esc.templateResolver.FindErrorTemplateForStatus(lookupPath, errorInfo.StatusCode)
 	if errorTemplate != nil {
 		esc.logger.Debug("Found specific error template",
 			zap.String("template", errorTemplate.FilePath),
 			zap.Int("error_code", errorTemplate.ErrorCode))
+	}
+
+	return &errorInfoComponent{
+		info:          &errorInfo,
+		errorTemplate: errorTemplate,
+		service:       esc,
+	}
+}
 
-		// TODO: Use OptimizedTemplateService to render proper error template
-		// This replaces the TODO from the original error_service.go:262
-		component := esc.tryRenderErrorTemplate(errorTemplate, message, path)
-		if component != nil {
-			return component
+// errorInfoComponent renders an error template with the localized error info
+type errorInfoComponent struct {
+	info          *interfaces.ErrorInfo
+	errorTemplate *interfaces.ErrorTemplate
+	service       *ErrorServiceCore
+}
+
+// Render localizes the message for the request locale and renders the error template,
+// falling back to the built-in error page
+func (eic *errorInfoComponent) Render(ctx context.Context, w io.Writer) error {
+	info := *eic.info
+	if info.Message == "" {
+		info.Message = i18n.TOrDefault(ctx, info.MessageKey, interfaces.DefaultErrorMessage(info.MessageKey))
+	}
+	ctx = context.WithValue(ctx, shared.ErrorInfoKey, &info)
+
+	if eic.errorTemplate != nil {
+		if component := eic.service.tryRenderErrorTemplate(eic.errorTemplate, &info); component != nil {
+			return component.Render(ctx, w)
 		}
 	}
 
+	// Invalid route parameters have a dedicated built-in page
+	if info.Parameter != "" {
+		return templates.ParameterValidationError(templates.ParameterValidationErrorData{
+			ErrorMessage: info.Message,
+			RoutePath:    info.Path,
+		}).Render(ctx, w)
+	}
+
 	// Fallback to simple HTML error component
-	esc.logger.Debug("Using fallback error renderer",
-		zap.String("path", path))
+	eic.service.logger.Debug("Using fallback error renderer",
+		zap.String("path", info.Path))
 
-	return esc.renderer.RenderErrorHTML(500, message, "fallback", path)
+	return eic.service.renderer.RenderErrorHTML(info.StatusCode, info.Message, "fallback", info.Path).Render(ctx, w)
 }
 
 // tryRenderErrorTemplate attempts to render an error template using the dedicated error service
-func (esc *ErrorServiceCore) tryRenderErrorTemplate(errorTemplate *interfaces.ErrorTemplate, message, path string) templ.Component {
+func (esc *ErrorServiceCore) tryRenderErrorTemplate(errorTemplate *interfaces.ErrorTemplate, info *interfaces.ErrorInfo) templ.Component {
 	// FIXED: Use dedicated error template service instead of OptimizedTemplateService
 	// This solves the conflict where OptimizedTemplateService would resolve wrong templates for error paths
 
 	esc.logger.Debug("Attempting error template resolution through DedicatedErrorTemplateService",
 		zap.String("template", errorTemplate.FilePath),
-		zap.String("message", message),
-		zap.String("path", path))
+		zap.String("message", info.Message),
+		zap.String("path", info.Path))
 
 	// Check if error template is available in registry
 	if !esc.dedicatedErrorService.IsErrorTemplateAvailable(errorTemplate) {
@@ -94,9 +156,11 @@ func (esc *ErrorServiceCore) tryRenderErrorTemplate(errorTemplate *interfaces.Er
 
 	// Create error context for template
 	errorContext := &ErrorContext{
-		StatusCode:  errorTemplate.ErrorCode,
-		Message:     message,
-		RequestPath: path,
+		StatusCode:  info.StatusCode,
+		Message:     info.Message,
+		RequestPath: info.Path,
+		ErrorID:     info.RequestID,
+		Info:        info,
 	}
 
 	// Render error template using dedicated service
@@ -110,7 +174,7 @@ func (esc *ErrorServiceCore) tryRenderErrorTemplate(errorTemplate *interfaces.Er
 
 	esc.logger.Info("Successfully rendered error template",
 		zap.String("template", errorTemplate.FilePath),
-		zap.String("path", path))
+		zap.String("path", info.Path))
 
 	return component
 }
@@ -123,4 +187,7 @@ type ErrorContext struct {
 	UserAgent   string `json:"user_agent,omitempty"`
 	Timestamp   string `json:"timestamp,omitempty"`
 	ErrorID     string `json:"error_id,omitempty"`
+
+	// Info carries the full error info for templates taking *interfaces.ErrorInfo
+	Info *interfaces.ErrorInfo `json:"-"`
 }
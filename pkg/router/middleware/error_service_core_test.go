@@ -0,0 +1,207 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/i18n"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// errorTestConfigService switches between development and production
+type errorTestConfigService struct {
+	layoutTestConfigService
+	production bool
+}
+
+func (m *errorTestConfigService) IsDevelopment() bool { return !m.production }
+
+// errorInfoTestService renders the error info found in the context
+type errorInfoTestService struct {
+	rendered *interfaces.ErrorTemplate
+}
+
+func (m *errorInfoTestService) IsErrorTemplateAvailable(errorTemplate *interfaces.ErrorTemplate) bool {
+	return true
+}
+
+func (m *errorInfoTestService) RenderErrorTemplate(errorTemplate *interfaces.ErrorTemplate, errorContext *ErrorContext) (templ.Component, error) {
+	m.rendered = errorTemplate
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		info, _ := ctx.Value(shared.ErrorInfoKey).(*interfaces.ErrorInfo)
+		_, err := fmt.Fprintf(w, "%s %d %s cause=%v", errorTemplate.ComponentName, info.StatusCode, info.Message, info.Cause)
+		return err
+	}), nil
+}
+
+func setupErrorTemplateTree(t *testing.T) string {
+	rootDir := filepath.Join(t.TempDir(), "app")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "error.templ"), "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "locale_", "not-found.templ"), "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "locale_", "admin", "error.templ"), "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "locale_", "admin", "error.403.templ"), "")
+	return rootDir
+}
+
+func newTestErrorTemplateResolver(rootDir string) *errorTemplateResolverImpl {
+	logger := zap.NewNop()
+	return &errorTemplateResolverImpl{
+		configService:     &layoutTestConfigService{rootDir: rootDir},
+		fileSystemChecker: &ProductiveFileSystemChecker{logger: logger},
+		logger:            logger,
+	}
+}
+
+func TestErrorTemplateResolverFindErrorTemplateForStatus(t *testing.T) {
+	rootDir := setupErrorTemplateTree(t)
+	resolver := newTestErrorTemplateResolver(rootDir)
+
+	tests := []struct {
+		name              string
+		path              string
+		status            int
+		expectedFile      string
+		expectedComponent string
+		expectedCode      int
+	}{
+		{
+			name:              "Numeric status template in the nearest directory",
+			path:              "/en/admin/users",
+			status:            http.StatusForbidden,
+			expectedFile:      filepath.Join(rootDir, "locale_", "admin", "error.403.templ"),
+			expectedComponent: "Error403",
+			expectedCode:      http.StatusForbidden,
+		},
+		{
+			name:              "Nearest generic template before a parent status template",
+			path:              "/en/admin",
+			status:            http.StatusNotFound,
+			expectedFile:      filepath.Join(rootDir, "locale_", "admin", "error.templ"),
+			expectedComponent: "Error",
+			expectedCode:      http.StatusNotFound,
+		},
+		{
+			name:              "Named status template below a dynamic directory",
+			path:              "/de/missing/page",
+			status:            http.StatusNotFound,
+			expectedFile:      filepath.Join(rootDir, "locale_", "not-found.templ"),
+			expectedComponent: "NotFound",
+			expectedCode:      http.StatusNotFound,
+		},
+		{
+			name:              "Template directory path",
+			path:              "/locale_/admin",
+			status:            http.StatusForbidden,
+			expectedFile:      filepath.Join(rootDir, "locale_", "admin", "error.403.templ"),
+			expectedComponent: "Error403",
+			expectedCode:      http.StatusForbidden,
+		},
+		{
+			name:              "Root error template for other statuses",
+			path:              "/en/reports",
+			status:            http.StatusInternalServerError,
+			expectedFile:      filepath.Join(rootDir, "error.templ"),
+			expectedComponent: "Error",
+			expectedCode:      http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errorTemplate := resolver.FindErrorTemplateForStatus(tt.path, tt.status)
+
+			require.NotNil(t, errorTemplate)
+			assert.Equal(t, tt.expectedFile, errorTemplate.FilePath)
+			assert.Equal(t, tt.expectedComponent, errorTemplate.ComponentName)
+			assert.Equal(t, tt.expectedCode, errorTemplate.ErrorCode)
+		})
+	}
+}
+
+func TestErrorServiceCoreCreateErrorInfoComponent(t *testing.T) {
+	rootDir := setupErrorTemplateTree(t)
+
+	newService := func(production bool) (*ErrorServiceCore, *errorInfoTestService) {
+		dedicated := &errorInfoTestService{}
+		return &ErrorServiceCore{
+			templateResolver:      newTestErrorTemplateResolver(rootDir),
+			renderer:              NewErrorRenderer(),
+			dedicatedErrorService: dedicated,
+			configService:         &errorTestConfigService{layoutTestConfigService: layoutTestConfigService{rootDir: rootDir}, production: production},
+			logger:                zap.NewNop(),
+		}, dedicated
+	}
+
+	render := func(t *testing.T, component templ.Component, ctx context.Context) string {
+		var sb strings.Builder
+		require.NoError(t, component.Render(ctx, &sb))
+		return sb.String()
+	}
+
+	t.Run("Status template with default message", func(t *testing.T) {
+		service, dedicated := newService(false)
+		info := interfaces.NewErrorInfo(http.StatusNotFound, "/en/missing")
+
+		body := render(t, service.CreateErrorInfoComponent(info, "/en/missing"), context.Background())
+
+		assert.Equal(t, filepath.Join(rootDir, "locale_", "not-found.templ"), dedicated.rendered.FilePath)
+		assert.Equal(t, "NotFound 404 The requested page could not be found. cause=<nil>", body)
+	})
+
+	t.Run("Localized message", func(t *testing.T) {
+		service, _ := newService(false)
+		ctx := context.WithValue(context.Background(), shared.I18nDataKey, &i18n.I18nData{
+			Locale:       "de",
+			Translations: map[string]string{interfaces.ErrorMessageKeyForbidden: "Zugriff verweigert."},
+		})
+
+		body := render(t, service.CreateErrorInfoComponent(interfaces.NewErrorInfo(http.StatusForbidden, "/de/admin"), "/de/admin"), ctx)
+
+		assert.Equal(t, "Error403 403 Zugriff verweigert. cause=<nil>", body)
+	})
+
+	t.Run("Cause is only kept in development", func(t *testing.T) {
+		info := interfaces.NewErrorInfo(http.StatusInternalServerError, "/en/reports")
+		info.Cause = errors.New("database unavailable")
+
+		service, _ := newService(false)
+		assert.Contains(t, render(t, service.CreateErrorInfoComponent(info, "/en/reports"), context.Background()), "cause=database unavailable")
+
+		service, _ = newService(true)
+		assert.Contains(t, render(t, service.CreateErrorInfoComponent(info, "/en/reports"), context.Background()), "cause=<nil>")
+		assert.NotNil(t, info.Cause, "the caller's info must not be modified")
+	})
+
+	t.Run("Fallback page without error templates", func(t *testing.T) {
+		service, _ := newService(false)
+		service.templateResolver = newTestErrorTemplateResolver(t.TempDir())
+
+		body := render(t, service.CreateErrorInfoComponent(interfaces.NewErrorInfo(http.StatusMethodNotAllowed, "/api"), "/api"), context.Background())
+
+		assert.Contains(t, body, "405")
+	})
+
+	t.Run("Parameter validation page without error templates", func(t *testing.T) {
+		service, _ := newService(false)
+		service.templateResolver = newTestErrorTemplateResolver(t.TempDir())
+		info := interfaces.NewErrorInfo(http.StatusNotFound, "/en/data/User-42")
+		info.Parameter = "userId"
+
+		body := render(t, service.CreateErrorInfoComponent(info, "/en/data"), context.Background())
+
+		assert.Contains(t, body, "Parameter Validation Error")
+		assert.Contains(t, body, "The requested page could not be found.")
+		assert.Contains(t, body, "/en/data/User-42")
+	})
+}
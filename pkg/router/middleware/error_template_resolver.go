@@ -1,10 +1,12 @@
 package middleware
 
 import (
+	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -12,6 +14,7 @@ import (
 // ErrorTemplateResolver interface for error template resolution (PUBLIC)
 type ErrorTemplateResolver interface {
 	FindErrorTemplateForPath(path string) *interfaces.ErrorTemplate
+	FindErrorTemplateForStatus(path string, status int) *interfaces.ErrorTemplate
 }
 
 // errorTemplateResolverImpl implements ErrorTemplateResolver (PRIVATE)
@@ -37,13 +40,22 @@ func NewErrorTemplateResolver(i do.Injector) (ErrorTemplateResolver, error) {
 // FindErrorTemplateForPath finds the most specific error template for a given path
 // This implements the hierarchical error template resolution logic
 func (etr *errorTemplateResolverImpl) FindErrorTemplateForPath(path string) *interfaces.ErrorTemplate {
-	etr.logger.Debug("Finding error template for path", zap.String("path", path))
+	return etr.FindErrorTemplateForStatus(path, 0)
+}
+
+// FindErrorTemplateForStatus finds the most specific error template for a path and status code.
+// The nearest directory wins; within a directory error.404.templ and not-found.templ
+// take precedence over error.templ.
+func (etr *errorTemplateResolverImpl) FindErrorTemplateForStatus(path string, status int) *interfaces.ErrorTemplate {
+	etr.logger.Debug("Finding error template for path",
+		zap.String("path", path),
+		zap.Int("status", status))
 
 	// Get template root directory from config
 	templateRoot := etr.configService.GetLayoutRootDirectory()
 
 	// Generate candidate paths for error templates (most specific to least specific)
-	candidatePaths := etr.generateErrorTemplateCandidates(path, templateRoot)
+	candidatePaths := etr.generateErrorTemplateCandidates(path, templateRoot, status)
 
 	// Try each candidate path
 	for _, candidatePath := range candidatePaths {
@@ -55,7 +67,7 @@ func (etr *errorTemplateResolverImpl) FindErrorTemplateForPath(path string) *int
 			return &interfaces.ErrorTemplate{
 				FilePath:      candidatePath,
 				ComponentName: etr.generateComponentName(candidatePath),
-				ErrorCode:     etr.extractErrorCodeFromPath(candidatePath),
+				ErrorCode:     etr.extractErrorCodeFromPath(candidatePath, status),
 			}
 		}
 	}
@@ -66,29 +78,16 @@ func (etr *errorTemplateResolverImpl) FindErrorTemplateForPath(path string) *int
 
 // generateErrorTemplateCandidates generates candidate paths for error templates
 // in order of specificity (most specific first)
-func (etr *errorTemplateResolverImpl) generateErrorTemplateCandidates(path, templateRoot string) []string {
+func (etr *errorTemplateResolverImpl) generateErrorTemplateCandidates(path, templateRoot string, status int) []string {
 	var candidates []string
 
-	// Clean and normalize the path
-	cleanPath := strings.Trim(path, "/")
-	pathSegments := strings.Split(cleanPath, "/")
-
-	// Generate candidates from most specific to least specific
-	// Example: /admin/users/123 -> [admin/users/error.templ, admin/error.templ, error.templ]
-
-	for i := len(pathSegments); i >= 0; i-- {
-		var candidatePath string
-
-		if i == 0 {
-			// Root error template
-			candidatePath = filepath.Join(templateRoot, "error.templ")
-		} else {
-			// Directory-specific error template
-			dirPath := strings.Join(pathSegments[:i], "/")
-			candidatePath = filepath.Join(templateRoot, dirPath, "error.templ")
+	// Example for a 404 below /admin/users:
+	// admin/users/error.404.templ, admin/users/not-found.templ, admin/users/error.templ, admin/error.404.templ, ...
+	templateNames := shared.ErrorTemplateNames(status)
+	for _, dir := range etr.candidateDirectories(path, templateRoot) {
+		for _, templateName := range templateNames {
+			candidates = append(candidates, filepath.Join(dir, templateName+".templ"))
 		}
-
-		candidates = append(candidates, candidatePath)
 	}
 
 	etr.logger.Debug("Generated error template candidates",
@@ -98,29 +97,79 @@ func (etr *errorTemplateResolverImpl) generateErrorTemplateCandidates(path, temp
 	return candidates
 }
 
-// generateComponentName generates a component name from the template file path
-func (etr *errorTemplateResolverImpl) generateComponentName(templatePath string) string {
-	// Convert file path to component name
-	// Example: app/admin/error.templ -> AdminError
+// candidateDirectories maps a request or template path onto the template directories it
+// passes through, deepest first. Segments without a literal directory match a dynamic
+// directory (locale_, slug___), e.g. /en/dashboard -> app/locale_/dashboard, app/locale_, app
+func (etr *errorTemplateResolverImpl) candidateDirectories(path, templateRoot string) []string {
+	dirs := []string{templateRoot}
+	current := templateRoot
+
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if segment == "" {
+			break
+		}
+
+		if literal := filepath.Join(current, segment); etr.fileSystemChecker.IsDirectory(literal) {
+			current = literal
+			dirs = append(dirs, current)
+			continue
+		}
+
+		dynamic := etr.dynamicDirectory(current)
+		if dynamic == "" {
+			break
+		}
+		current = filepath.Join(current, dynamic)
+		dirs = append(dirs, current)
 
-	dir := filepath.Dir(templatePath)
-	base := filepath.Base(dir)
+		// A catch-all directory consumes the remaining segments
+		if _, _, ok := shared.DirectoryToCatchAll(dynamic); ok {
+			break
+		}
+	}
+
+	// Deepest directory first
+	for i, j := 0, len(dirs)-1; i < j; i, j = i+1, j-1 {
+		dirs[i], dirs[j] = dirs[j], dirs[i]
+	}
+	return dirs
+}
+
+// dynamicDirectory returns the first dynamic parameter directory below dir ("" if none)
+func (etr *errorTemplateResolverImpl) dynamicDirectory(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
 
-	if base == "." || base == "/" {
-		return "Error"
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasSuffix(entry.Name(), "_") {
+			return entry.Name()
+		}
 	}
+	return ""
+}
 
-	// Capitalize first letter and add "Error" suffix
-	componentName := strings.Title(base) + "Error"
-	return componentName
+// generateComponentName returns the component an error template defines
+// Example: app/admin/error.templ -> Error, app/not-found.templ -> NotFound
+func (etr *errorTemplateResolverImpl) generateComponentName(templatePath string) string {
+	templateName := strings.TrimSuffix(filepath.Base(templatePath), ".templ")
+	if componentName, ok := shared.ErrorTemplateFunctionName(templateName); ok {
+		return componentName
+	}
+	return "Error"
 }
 
-// extractErrorCodeFromPath extracts error code from template path if present
-func (etr *errorTemplateResolverImpl) extractErrorCodeFromPath(templatePath string) int {
-	// Check if the path contains specific error codes
-	// Example: app/404/error.templ -> 404
+// extractErrorCodeFromPath extracts the error code from the template name or directory,
+// falling back to the requested status (500 if none was requested)
+func (etr *errorTemplateResolverImpl) extractErrorCodeFromPath(templatePath string, status int) int {
+	// Example: app/error.404.templ -> 404, app/404/error.templ -> 404
+	templateName := strings.TrimSuffix(filepath.Base(templatePath), ".templ")
+	if code, ok := shared.ErrorTemplateStatus(templateName); ok && code != 0 {
+		return code
+	}
 
-	dir := filepath.Dir(templatePath)
+	dir := filepath.ToSlash(filepath.Dir(templatePath))
 	segments := strings.Split(dir, "/")
 
 	for _, segment := range segments {
@@ -136,6 +185,10 @@ func (etr *errorTemplateResolverImpl) extractErrorCodeFromPath(templatePath stri
 		}
 	}
 
+	if status != 0 {
+		return status
+	}
+
 	// Default to 500 for generic error templates
 	return 500
 }
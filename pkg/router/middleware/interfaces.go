@@ -7,7 +7,6 @@ import (
 
 	"github.com/a-h/templ"
 	"github.com/denkhaus/templ-router/pkg/interfaces"
-	"github.com/go-chi/chi/v5"
 )
 
 // Common errors
@@ -30,18 +29,24 @@ type I18nService interface {
 // TemplateService handles template rendering
 type TemplateService interface {
 	RenderComponent(route interfaces.Route, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error)
+	// RenderFragment renders a named parameterless component exported by the page package
+	RenderFragment(route interfaces.Route, fragment string, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error)
 	RenderLayoutComponent(layoutPath string, content templ.Component, ctx context.Context) (templ.Component, error)
 }
 
 // LayoutService handles layout resolution and wrapping
 type LayoutService interface {
 	FindLayoutForTemplate(templatePath string) *interfaces.LayoutTemplate
+	FindNamedLayout(name string) *interfaces.LayoutTemplate
 	WrapInLayout(component templ.Component, layout *interfaces.LayoutTemplate, ctx context.Context) templ.Component
 }
 
 // ErrorService handles error template resolution
 type ErrorService interface {
 	CreateErrorComponent(message, path string) templ.Component
+	// CreateErrorInfoComponent renders the nearest error template for the status of info,
+	// starting the lookup at lookupPath
+	CreateErrorInfoComponent(info *interfaces.ErrorInfo, lookupPath string) templ.Component
 }
 
 // AuthMiddlewareInterface handles authentication middleware
@@ -59,9 +64,50 @@ type TemplateMiddlewareInterface interface {
 	Handle(route interfaces.Route, params map[string]string) http.Handler
 }
 
+// ParameterValidationMiddlewareInterface validates dynamic route parameters
+type ParameterValidationMiddlewareInterface interface {
+	Handle(next http.Handler, route interfaces.Route, settings *interfaces.DynamicSettings) http.Handler
+}
+
+// ContentNegotiationMiddlewareInterface serves the DataService result of a page as JSON
+type ContentNegotiationMiddlewareInterface interface {
+	Handle(next http.Handler, route interfaces.Route, settings *interfaces.APISettings) http.Handler
+	// HandleSuffix removes a .json suffix that the trailing route parameter matched
+	HandleSuffix(next http.Handler, route interfaces.Route, settings *interfaces.APISettings) http.Handler
+}
+
+// ActionMiddlewareInterface runs the Action of a page for form submissions
+type ActionMiddlewareInterface interface {
+	Handle(next http.Handler, route interfaces.Route) http.Handler
+}
+
+// RecoveryMiddlewareInterface turns panics of a route handler into error pages
+type RecoveryMiddlewareInterface interface {
+	Handle(next http.Handler, route interfaces.Route) http.Handler
+}
+
+// RateLimitMiddlewareInterface limits the requests of clients to a route
+type RateLimitMiddlewareInterface interface {
+	Handle(next http.Handler, route interfaces.Route, settings *interfaces.RateLimitSettings) http.Handler
+}
+
+// SecurityHeadersMiddlewareInterface sets the security headers of responses
+type SecurityHeadersMiddlewareInterface interface {
+	// Handle sets the security headers of all routes and the CSP nonce of the request
+	Handle(next http.Handler) http.Handler
+	// HandleRoute applies the security settings of a route
+	HandleRoute(next http.Handler, route interfaces.Route, settings *interfaces.SecuritySettings) http.Handler
+}
+
+// CSRFMiddlewareInterface protects state-changing requests against cross-site request forgery
+type CSRFMiddlewareInterface interface {
+	Handle(next http.Handler) http.Handler
+}
+
 // RouterMiddlewareInterface handles router-level middleware configuration
 type RouterMiddlewareInterface interface {
-	ConfigureRouterMiddleware(chiRouter *chi.Mux) error
+	// ConfigureRouterMiddleware wraps the router handler with the configured router-level middleware
+	ConfigureRouterMiddleware(next http.Handler) (http.Handler, error)
 }
 
 
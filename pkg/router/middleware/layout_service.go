@@ -8,6 +8,7 @@ import (
 
 	"github.com/a-h/templ"
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/metadata"
 	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
@@ -35,19 +36,58 @@ func NewLayoutService(i do.Injector) (interfaces.LayoutService, error) {
 	}, nil
 }
 
-// FindLayoutForTemplate finds the appropriate layout for a template using Next.js-style layout inheritance
+// FindLayoutForTemplate finds the layout chain for a template using Next.js-style layout inheritance.
+// It returns the closest layout; its Parent links lead to the layouts of the parent directories.
 func (ls *layoutServiceImpl) FindLayoutForTemplate(templatePath string) *interfaces.LayoutTemplate {
 	ls.logger.Debug("Finding layout for template", zap.String("template_path", templatePath))
 
+	// Start from the template's directory and walk up the directory tree
+	return ls.findLayoutChain(filepath.Dir(templatePath))
+}
+
+// FindNamedLayout finds a layout selected in metadata ("layout: <name>") together with its
+// enclosing layouts. Returns nil if the named layout does not exist.
+func (ls *layoutServiceImpl) FindNamedLayout(name string) *interfaces.LayoutTemplate {
+	layoutPath := NamedLayoutPath(ls.config, name)
+	if layoutPath == "" || !ls.fileSystemChecker.FileExists(layoutPath) {
+		ls.logger.Warn("Named layout not found",
+			zap.String("name", name),
+			zap.String("layout_path", layoutPath))
+		return nil
+	}
+
+	return ls.findLayoutChain(filepath.Dir(layoutPath))
+}
+
+// NamedLayoutPath returns the layout file for a layout name from metadata.
+// Names are directories below the layout root ("print", "admin/reports", "/" for the root layout);
+// a path to the layout file itself is accepted too. Returns "" for names outside the layout root.
+func NamedLayoutPath(config interfaces.ConfigService, name string) string {
+	rootDir := filepath.Clean(config.GetLayoutRootDirectory())
+	layoutFileName := config.GetLayoutFileName() + config.GetTemplateExtension()
+
+	name = strings.Trim(filepath.ToSlash(strings.TrimSpace(name)), "/")
+	name = strings.TrimSuffix(strings.TrimSuffix(name, layoutFileName), "/")
+
+	layoutDir := filepath.Join(rootDir, filepath.FromSlash(name))
+	if relative, err := filepath.Rel(rootDir, layoutDir); err != nil || strings.HasPrefix(relative, "..") {
+		return ""
+	}
+
+	return filepath.Join(layoutDir, layoutFileName)
+}
+
+// findLayoutChain walks up from dir and links every layout found until the layout root
+// directory or a layout that does not inherit its parents
+func (ls *layoutServiceImpl) findLayoutChain(dir string) *interfaces.LayoutTemplate {
 	// Get layout configuration
-	rootDir := ls.config.GetLayoutRootDirectory()
+	rootDir := filepath.Clean(ls.config.GetLayoutRootDirectory())
 	layoutFileName := ls.config.GetLayoutFileName() + ls.config.GetTemplateExtension()
 	metadataExtension := ls.config.GetMetadataExtension()
-
-	// Start from the template's directory and walk up the directory tree
-	dir := filepath.Dir(templatePath)
+	inheritanceEnabled := ls.config.IsLayoutInheritanceEnabled()
 
 	layoutLevel := 0
+	var closest, outermost *interfaces.LayoutTemplate
 
 	for {
 		layoutPath := filepath.Join(dir, layoutFileName)
@@ -66,21 +106,39 @@ func (ls *layoutServiceImpl) FindLayoutForTemplate(templatePath string) *interfa
 				zap.String("metadata_path", metadataPath),
 				zap.Int("layout_level", layoutLevel))
 
-			return &interfaces.LayoutTemplate{
+			layout := &interfaces.LayoutTemplate{
 				FilePath:    layoutPath,
 				YamlPath:    metadataPath,
 				LayoutLevel: layoutLevel,
 			}
+
+			// Link the layout as the parent of the previously found (inner) layout
+			if closest == nil {
+				closest = layout
+			} else {
+				outermost.Parent = layout
+			}
+			outermost = layout
+
+			if !inheritanceEnabled || !ls.inheritsParentLayout(layout) {
+				return closest
+			}
+		}
+
+		// Layouts above the layout root directory are not part of the chain
+		if filepath.Clean(dir) == rootDir {
+			return closest
 		}
 
 		// Move to parent directory
 		parentDir := filepath.Dir(dir)
 		if parentDir == dir || parentDir == "." || parentDir == "" {
-			// Reached filesystem root without finding layout
-			ls.logger.Debug("Reached filesystem root, no layout found",
-				zap.String("template_path", templatePath),
-				zap.String("searched_up_to", dir))
-			return nil
+			// Reached filesystem root
+			ls.logger.Debug("Reached filesystem root",
+				zap.String("start_dir", dir),
+				zap.String("searched_up_to", dir),
+				zap.Bool("layout_found", closest != nil))
+			return closest
 		}
 
 		dir = parentDir
@@ -89,87 +147,118 @@ func (ls *layoutServiceImpl) FindLayoutForTemplate(templatePath string) *interfa
 		// Prevent infinite loops - reasonable depth limit
 		if layoutLevel > 10 {
 			ls.logger.Warn("Layout search depth limit reached",
-				zap.String("template_path", templatePath),
+				zap.String("start_dir", dir),
 				zap.Int("max_depth", layoutLevel))
-			return nil
+			return closest
 		}
 	}
 }
 
-// WrapInLayout wraps a component in a layout
+// inheritsParentLayout checks the layout metadata for a "layout: { inherit: false }" opt-out
+func (ls *layoutServiceImpl) inheritsParentLayout(layout *interfaces.LayoutTemplate) bool {
+	if layout.YamlPath == "" || !ls.fileSystemChecker.FileExists(layout.YamlPath) {
+		return true
+	}
+
+	_, layoutConfig, err := shared.ParseYAMLMetadata(layout.YamlPath)
+	if err != nil {
+		ls.logger.Warn("Failed to load layout metadata, inheriting parent layout",
+			zap.String("yaml_path", layout.YamlPath),
+			zap.Error(err))
+		return true
+	}
+
+	settings := metadata.NewMetadataSettingsParser().ParseLayoutSettings(layoutConfig.LayoutSettings)
+	if !settings.Inherit {
+		ls.logger.Debug("Layout does not inherit parent layouts",
+			zap.String("layout_path", layout.FilePath))
+	}
+	return settings.Inherit
+}
+
+// WrapInLayout wraps a component in a layout and all of its enclosing layouts (innermost first)
 func (ls *layoutServiceImpl) WrapInLayout(component templ.Component, layout *interfaces.LayoutTemplate, ctx context.Context) templ.Component {
-	ls.logger.Debug("Wrapping component in layout", zap.String("layout_path", layout.FilePath))
+	chain := layout.Chain()
 
-	// Load layout metadata and merge with existing template metadata
-	if layout.YamlPath != "" {
-		configFileFound, layoutConfig, err := shared.ParseYAMLMetadata(layout.YamlPath)
-		if err != nil {
-			if configFileFound {
-				ls.logger.Warn("Failed to load layout metadata",
-					zap.String("yaml_path", layout.YamlPath),
-					zap.Error(err),
-				)
-			}
-		} else {
-			// CRITICAL FIX: Template metadata should override layout metadata
-			// Get existing template config from context
-			if existingConfig := ctx.Value(shared.TemplateConfigKey); existingConfig != nil {
-				if templateConfig, ok := existingConfig.(*shared.ConfigFile); ok {
-					// Merge configs: template metadata takes precedence over layout metadata
-					mergedConfig := mergeConfigs(layoutConfig, templateConfig)
-					ctx = context.WithValue(ctx, shared.TemplateConfigKey, mergedConfig)
-
-					// Safe access to metadata
-					templateTitle := ""
-					layoutTitle := ""
-					if mergedConfig.RouteMetadata != nil {
-						if metadataMap, ok := mergedConfig.RouteMetadata.(map[string]interface{}); ok {
-							if title, exists := metadataMap["title"]; exists {
-								if titleStr, ok := title.(string); ok {
-									templateTitle = titleStr
-								}
-							}
-						}
-					}
-					if layoutConfig.RouteMetadata != nil {
-						if metadataMap, ok := layoutConfig.RouteMetadata.(map[string]interface{}); ok {
-							if title, exists := metadataMap["title"]; exists {
-								if titleStr, ok := title.(string); ok {
-									layoutTitle = titleStr
-								}
-							}
-						}
-					}
-
-					ls.logger.Info("Merged template and layout metadata (template takes precedence)",
-						zap.String("layout_yaml", layout.YamlPath),
-						zap.String("template_title", templateTitle),
-						zap.String("layout_title", layoutTitle))
-				} else {
-					// Fallback: use layout config if template config is invalid
-					ctx = context.WithValue(ctx, shared.TemplateConfigKey, layoutConfig)
-					ls.logger.Info("Added layout metadata to context (fallback)",
-						zap.String("yaml_path", layout.YamlPath),
-						zap.Any("metadata", layoutConfig.RouteMetadata))
-				}
-			} else {
-				// No existing template config, use layout config
-				ctx = context.WithValue(ctx, shared.TemplateConfigKey, layoutConfig)
-				ls.logger.Info("Added layout metadata to context (no template config)",
-					zap.String("yaml_path", layout.YamlPath),
-					zap.Any("metadata", layoutConfig.RouteMetadata))
-			}
+	// Merge metadata innermost to outermost: the template overrides its closest layout,
+	// which overrides the layouts further out. Every level renders with the merged config.
+	for _, chainLayout := range chain {
+		ctx = ls.mergeLayoutMetadata(chainLayout, ctx)
+	}
+
+	wrapped := component
+	for _, chainLayout := range chain {
+		ls.logger.Debug("Wrapping component in layout",
+			zap.String("layout_path", chainLayout.FilePath),
+			zap.Int("layout_level", chainLayout.LayoutLevel))
+
+		// Create a wrapped component that includes the layout context and template service
+		wrapped = &LayoutWrappedComponent{
+			innerComponent:  wrapped,
+			layoutContext:   ctx,
+			layoutPath:      chainLayout.FilePath,
+			templateService: ls.templateService,
+			logger:          ls.logger,
+		}
+	}
+
+	return wrapped
+}
+
+// mergeLayoutMetadata loads layout metadata and merges it with the template metadata in the context
+func (ls *layoutServiceImpl) mergeLayoutMetadata(layout *interfaces.LayoutTemplate, ctx context.Context) context.Context {
+	if layout.YamlPath == "" {
+		return ctx
+	}
+
+	configFileFound, layoutConfig, err := shared.ParseYAMLMetadata(layout.YamlPath)
+	if err != nil {
+		if configFileFound {
+			ls.logger.Warn("Failed to load layout metadata",
+				zap.String("yaml_path", layout.YamlPath),
+				zap.Error(err),
+			)
+		}
+		return ctx
+	}
+
+	// CRITICAL FIX: Template metadata should override layout metadata
+	// Get existing template config from context
+	if existingConfig := ctx.Value(shared.TemplateConfigKey); existingConfig != nil {
+		if templateConfig, ok := existingConfig.(*shared.ConfigFile); ok {
+			// Merge configs: template metadata takes precedence over layout metadata
+			mergedConfig := mergeConfigs(layoutConfig, templateConfig)
+
+			ls.logger.Info("Merged template and layout metadata (template takes precedence)",
+				zap.String("layout_yaml", layout.YamlPath),
+				zap.String("template_title", metadataTitle(mergedConfig.RouteMetadata)),
+				zap.String("layout_title", metadataTitle(layoutConfig.RouteMetadata)))
+
+			return context.WithValue(ctx, shared.TemplateConfigKey, mergedConfig)
 		}
+
+		// Fallback: use layout config if template config is invalid
+		ls.logger.Info("Added layout metadata to context (fallback)",
+			zap.String("yaml_path", layout.YamlPath),
+			zap.Any("metadata", layoutConfig.RouteMetadata))
+		return context.WithValue(ctx, shared.TemplateConfigKey, layoutConfig)
 	}
 
-	// Create a wrapped component that includes the layout context and template service
-	return &LayoutWrappedComponent{
-		innerComponent:  component,
-		layoutContext:   ctx,
-		layoutPath:      layout.FilePath,
-		templateService: ls.templateService,
-		logger:          ls.logger,
+	// No existing template config, use layout config
+	ls.logger.Info("Added layout metadata to context (no template config)",
+		zap.String("yaml_path", layout.YamlPath),
+		zap.Any("metadata", layoutConfig.RouteMetadata))
+	return context.WithValue(ctx, shared.TemplateConfigKey, layoutConfig)
+}
+
+// metadataTitle safely reads the title from route metadata for logging
+func metadataTitle(routeMetadata interface{}) string {
+	if metadataMap, ok := routeMetadata.(map[string]interface{}); ok {
+		if title, ok := metadataMap["title"].(string); ok {
+			return title
+		}
 	}
+	return ""
 }
 
 // LayoutWrappedComponent wraps a component with layout context
@@ -190,7 +279,13 @@ func (lwc *LayoutWrappedComponent) Render(ctx context.Context, w io.Writer) erro
 		lwc.logger.Info("Rendering layout via template service", zap.String("layout_path", lwc.layoutPath))
 
 		// Use template service to render layout with content (library-agnostic)
-		layoutComponent, err := lwc.templateService.RenderLayoutComponent(lwc.layoutPath, lwc.innerComponent, lwc.layoutContext)
+		// Streaming sends everything before the content of the layout right away
+		content := lwc.innerComponent
+		if isStreamingRender(lwc.layoutContext) {
+			content = &flushingComponent{content: content}
+		}
+
+		layoutComponent, err := lwc.templateService.RenderLayoutComponent(lwc.layoutPath, content, lwc.layoutContext)
 		if err != nil {
 			lwc.logger.Warn("Failed to render layout",
 				zap.String("layout_path", lwc.layoutPath),
@@ -225,10 +320,8 @@ func mergeConfigs(layoutConfig, templateConfig *shared.ConfigFile) *shared.Confi
 		}
 	}
 
-	// Override with template metadata (template takes precedence)
-	if templateConfig.RouteMetadata != nil {
-		merged.RouteMetadata = templateConfig.RouteMetadata
-	}
+	// Override with template metadata (template takes precedence per key)
+	merged.RouteMetadata = mergeRouteMetadata(layoutConfig.RouteMetadata, templateConfig.RouteMetadata)
 
 	// Override with template i18n data (template takes precedence)
 	if templateConfig.MultiLocaleI18n != nil {
@@ -249,3 +342,26 @@ func mergeConfigs(layoutConfig, templateConfig *shared.ConfigFile) *shared.Confi
 
 	return merged
 }
+
+// mergeRouteMetadata merges metadata maps key by key with template values taking precedence.
+// Non-map template metadata replaces the layout metadata.
+func mergeRouteMetadata(layoutMetadata, templateMetadata interface{}) interface{} {
+	if templateMetadata == nil {
+		return layoutMetadata
+	}
+
+	layoutMap, layoutIsMap := layoutMetadata.(map[string]interface{})
+	templateMap, templateIsMap := templateMetadata.(map[string]interface{})
+	if !layoutIsMap || !templateIsMap {
+		return templateMetadata
+	}
+
+	merged := make(map[string]interface{}, len(layoutMap)+len(templateMap))
+	for key, value := range layoutMap {
+		merged[key] = value
+	}
+	for key, value := range templateMap {
+		merged[key] = value
+	}
+	return merged
+}
@@ -0,0 +1,238 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// layoutTestConfigService points the layout root at a temporary directory
+type layoutTestConfigService struct {
+	mockRouterConfigService
+	rootDir            string
+	disableInheritance bool
+}
+
+func (m *layoutTestConfigService) GetLayoutRootDirectory() string   { return m.rootDir }
+func (m *layoutTestConfigService) IsLayoutInheritanceEnabled() bool { return !m.disableInheritance }
+func (m *layoutTestConfigService) GetMetadataExtension() string     { return ".templ.yaml" }
+
+// layoutTestTemplateService renders every layout as <dir>content</dir>
+type layoutTestTemplateService struct {
+	renderedTitles []string
+}
+
+func (m *layoutTestTemplateService) RenderComponent(route interfaces.Route, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error) {
+	return nil, nil
+}
+
+func (m *layoutTestTemplateService) RenderFragment(route interfaces.Route, fragment string, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error) {
+	return nil, nil
+}
+
+func (m *layoutTestTemplateService) RenderLayoutComponent(layoutPath string, content templ.Component, ctx context.Context) (templ.Component, error) {
+	name := filepath.Base(filepath.Dir(layoutPath))
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if config, ok := ctx.Value(shared.TemplateConfigKey).(*shared.ConfigFile); ok {
+			m.renderedTitles = append(m.renderedTitles, metadataTitle(config.RouteMetadata))
+		}
+		_, _ = io.WriteString(w, "<"+name+">")
+		if err := content.Render(ctx, w); err != nil {
+			return err
+		}
+		_, err := io.WriteString(w, "</"+name+">")
+		return err
+	}), nil
+}
+
+func writeLayoutTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+// setupLayoutTree creates app/layout.templ and app/locale_/dashboard/layout.templ with metadata
+func setupLayoutTree(t *testing.T, dashboardYaml string) string {
+	rootDir := filepath.Join(t.TempDir(), "app")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "layout.templ"), "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "layout.templ.yaml"), "metadata:\n  title: Root\n  description: Root description\n")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "locale_", "dashboard", "layout.templ"), "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "locale_", "dashboard", "layout.templ.yaml"), dashboardYaml)
+	return rootDir
+}
+
+func newTestLayoutService(config interfaces.ConfigService, templateService interfaces.TemplateService) *layoutServiceImpl {
+	logger := zap.NewNop()
+	return &layoutServiceImpl{
+		config:            config,
+		fileSystemChecker: &ProductiveFileSystemChecker{logger: logger},
+		templateService:   templateService,
+		logger:            logger,
+	}
+}
+
+func layoutChainPaths(layout *interfaces.LayoutTemplate) []string {
+	var paths []string
+	for _, chainLayout := range layout.Chain() {
+		paths = append(paths, chainLayout.FilePath)
+	}
+	return paths
+}
+
+func TestLayoutServiceFindLayoutForTemplate(t *testing.T) {
+	tests := []struct {
+		name               string
+		dashboardYaml      string
+		disableInheritance bool
+		expectedChain      []string
+	}{
+		{
+			name:          "Nested layouts form a chain",
+			dashboardYaml: "metadata:\n  section: Dashboard\n",
+			expectedChain: []string{"locale_/dashboard/layout.templ", "layout.templ"},
+		},
+		{
+			name:          "Inherit false stops the chain",
+			dashboardYaml: "layout:\n  inherit: false\n",
+			expectedChain: []string{"locale_/dashboard/layout.templ"},
+		},
+		{
+			name:               "Disabled inheritance uses the closest layout",
+			dashboardYaml:      "metadata:\n  section: Dashboard\n",
+			disableInheritance: true,
+			expectedChain:      []string{"locale_/dashboard/layout.templ"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rootDir := setupLayoutTree(t, tt.dashboardYaml)
+			service := newTestLayoutService(&layoutTestConfigService{rootDir: rootDir, disableInheritance: tt.disableInheritance}, nil)
+
+			layout := service.FindLayoutForTemplate(filepath.Join(rootDir, "locale_", "dashboard", "settings", "page.templ"))
+			require.NotNil(t, layout)
+
+			var expected []string
+			for _, path := range tt.expectedChain {
+				expected = append(expected, filepath.Join(rootDir, path))
+			}
+			assert.Equal(t, expected, layoutChainPaths(layout))
+			assert.Equal(t, 1, layout.LayoutLevel)
+		})
+	}
+}
+
+func TestLayoutServiceFindLayoutStopsAtRootDirectory(t *testing.T) {
+	rootDir := setupLayoutTree(t, "")
+	// A layout above the layout root directory must not join the chain
+	writeLayoutTestFile(t, filepath.Join(filepath.Dir(rootDir), "layout.templ"), "")
+
+	service := newTestLayoutService(&layoutTestConfigService{rootDir: rootDir}, nil)
+	layout := service.FindLayoutForTemplate(filepath.Join(rootDir, "about", "page.templ"))
+	require.NotNil(t, layout)
+	assert.Equal(t, []string{filepath.Join(rootDir, "layout.templ")}, layoutChainPaths(layout))
+}
+
+func TestLayoutServiceWrapInLayoutNestsAndMergesMetadata(t *testing.T) {
+	rootDir := setupLayoutTree(t, "metadata:\n  title: Dashboard\n  section: Dashboard\n")
+	templateService := &layoutTestTemplateService{}
+	service := newTestLayoutService(&layoutTestConfigService{rootDir: rootDir}, templateService)
+
+	layout := service.FindLayoutForTemplate(filepath.Join(rootDir, "locale_", "dashboard", "page.templ"))
+	require.NotNil(t, layout)
+
+	var pageConfig *shared.ConfigFile
+	page := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		pageConfig, _ = ctx.Value(shared.TemplateConfigKey).(*shared.ConfigFile)
+		_, err := io.WriteString(w, "page")
+		return err
+	})
+
+	ctx := context.WithValue(context.Background(), shared.TemplateConfigKey, &shared.ConfigFile{
+		RouteMetadata: map[string]interface{}{"title": "Settings"},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, service.WrapInLayout(page, layout, ctx).Render(ctx, &buf))
+
+	assert.Equal(t, "<app><dashboard>page</dashboard></app>", buf.String())
+	require.NotNil(t, pageConfig)
+	assert.Equal(t, map[string]interface{}{
+		"title":       "Settings",
+		"section":     "Dashboard",
+		"description": "Root description",
+	}, pageConfig.RouteMetadata)
+
+	// Every layout level renders with the fully merged metadata
+	assert.Equal(t, []string{"Settings", "Settings"}, templateService.renderedTitles)
+}
+
+func TestLayoutServiceFindNamedLayout(t *testing.T) {
+	rootDir := setupLayoutTree(t, "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "print", "layout.templ"), "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "print", "layout.templ.yaml"), "layout:\n  inherit: false\n")
+	service := newTestLayoutService(&layoutTestConfigService{rootDir: rootDir}, nil)
+
+	layout := service.FindNamedLayout("print")
+	require.NotNil(t, layout)
+	assert.Equal(t, []string{filepath.Join(rootDir, "print", "layout.templ")}, layoutChainPaths(layout))
+
+	layout = service.FindNamedLayout("locale_/dashboard/layout.templ")
+	require.NotNil(t, layout)
+	assert.Equal(t, []string{
+		filepath.Join(rootDir, "locale_", "dashboard", "layout.templ"),
+		filepath.Join(rootDir, "layout.templ"),
+	}, layoutChainPaths(layout))
+
+	assert.Nil(t, service.FindNamedLayout("missing"))
+	assert.Nil(t, service.FindNamedLayout("../outside"))
+}
+
+func TestTemplateMiddlewareResolveLayout(t *testing.T) {
+	rootDir := setupLayoutTree(t, "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "print", "layout.templ"), "")
+	service := newTestLayoutService(&layoutTestConfigService{rootDir: rootDir}, nil)
+	tm := &templateMiddleware{layoutService: service, logger: zap.NewNop()}
+
+	route := interfaces.Route{TemplateFile: filepath.Join(rootDir, "locale_", "dashboard", "page.templ")}
+	dashboardChain := []string{
+		filepath.Join(rootDir, "locale_", "dashboard", "layout.templ"),
+		filepath.Join(rootDir, "layout.templ"),
+	}
+
+	tests := []struct {
+		name           string
+		layoutSettings interface{}
+		expectedChain  []string
+	}{
+		{name: "No layout settings uses the nearest layout", expectedChain: dashboardChain},
+		{name: "Layout none", layoutSettings: "none", expectedChain: nil},
+		{name: "Named layout", layoutSettings: "print", expectedChain: []string{filepath.Join(rootDir, "print", "layout.templ"), filepath.Join(rootDir, "layout.templ")}},
+		{name: "Missing named layout falls back", layoutSettings: "missing", expectedChain: dashboardChain},
+		{name: "Page without inheritance", layoutSettings: map[string]interface{}{"inherit": false}, expectedChain: dashboardChain[:1]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), shared.TemplateConfigKey, &shared.ConfigFile{LayoutSettings: tt.layoutSettings})
+
+			layout := tm.resolveLayout(ctx, route)
+			if tt.expectedChain == nil {
+				assert.Nil(t, layout)
+				return
+			}
+			require.NotNil(t, layout)
+			assert.Equal(t, tt.expectedChain, layoutChainPaths(layout))
+		})
+	}
+}
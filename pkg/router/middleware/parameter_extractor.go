@@ -5,7 +5,7 @@ import (
 	"strings"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
-	"github.com/go-chi/chi/v5"
+	"github.com/denkhaus/templ-router/pkg/router/adapter"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -118,25 +118,15 @@ func (cpe *ConfigurableParameterExtractor) ExtractParameters(urlPath string, rou
 	return params
 }
 
-// ExtractParametersFromRequest extracts parameters from HTTP request using Chi's URL parameters
+// ExtractParametersFromRequest extracts parameters from HTTP request using the router adapter's URL parameters
 func (cpe *ConfigurableParameterExtractor) ExtractParametersFromRequest(r *http.Request, route interfaces.Route) map[string]string {
-	params := make(map[string]string)
-
-	// Extract all Chi URL parameters generically
-	rctx := chi.RouteContext(r.Context())
-	if rctx != nil {
-		for i, key := range rctx.URLParams.Keys {
-			if i < len(rctx.URLParams.Values) {
-				value := rctx.URLParams.Values[i]
-				if value != "" {
-					params[key] = value
-					cpe.logger.Debug("Extracted URL parameter from Chi",
-						zap.String("key", key),
-						zap.String("value", value),
-						zap.String("url_path", r.URL.Path))
-				}
-			}
-		}
+	// Extract all URL parameters of the matched route generically
+	params := adapter.FromRequest(r).URLParams(r)
+	for key, value := range params {
+		cpe.logger.Debug("Extracted URL parameter",
+			zap.String("key", key),
+			zap.String("value", value),
+			zap.String("url_path", r.URL.Path))
 	}
 
 	// Extract locale from URL path (first segment)
@@ -0,0 +1,190 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/adapter"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+// parameterValidationMiddleware enforces dynamic.parameters rules from .templ.yaml (private implementation)
+type parameterValidationMiddleware struct {
+	errorService  interfaces.ErrorService
+	configService interfaces.ConfigService
+	logger        *zap.Logger
+}
+
+// parameterRule is a compiled validation rule for a single route parameter
+type parameterRule struct {
+	name            string
+	pattern         *regexp.Regexp
+	supportedValues []string
+	paramType       string
+	format          string
+	optional        bool
+}
+
+// NewParameterValidationMiddleware creates a new parameter validation middleware for DI
+func NewParameterValidationMiddleware(i do.Injector) (ParameterValidationMiddlewareInterface, error) {
+	errorService := do.MustInvoke[interfaces.ErrorService](i)
+	configService := do.MustInvoke[interfaces.ConfigService](i)
+	logger := do.MustInvoke[*zap.Logger](i)
+
+	return &parameterValidationMiddleware{
+		errorService:  errorService,
+		configService: configService,
+		logger:        logger,
+	}, nil
+}
+
+// Handle rejects requests whose route parameters violate the configured rules
+// before the template (and its data service) is executed
+func (pvm *parameterValidationMiddleware) Handle(next http.Handler, route interfaces.Route, settings *interfaces.DynamicSettings) http.Handler {
+	rules := pvm.compileRules(route, settings)
+	if len(rules) == 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		typedParams := make(map[string]interface{})
+
+		for _, rule := range rules {
+			value := adapter.FromRequest(r).URLParam(r, rule.name)
+			if value == "" && rule.optional {
+				continue
+			}
+
+			typedValue, err := rule.apply(value)
+			if err != nil {
+				pvm.logger.Info("Route parameter failed validation",
+					zap.String("route", route.Path),
+					zap.String("parameter", rule.name),
+					zap.String("path", r.URL.Path),
+					zap.Error(err))
+
+				pvm.renderValidationError(w, r, route, rule.name)
+				return
+			}
+			if rule.isTyped() {
+				typedParams[rule.name] = typedValue
+			}
+		}
+
+		// Expose converted values to RouterContext typed accessors (parsed once per request)
+		if len(typedParams) > 0 {
+			r = r.WithContext(context.WithValue(r.Context(), shared.TypedURLParamsKey, typedParams))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// compileRules compiles the rules for all parameters that appear in the route pattern
+func (pvm *parameterValidationMiddleware) compileRules(route interfaces.Route, settings *interfaces.DynamicSettings) []parameterRule {
+	if settings == nil || len(settings.Parameters) == 0 {
+		return nil
+	}
+
+	optionalParams := make(map[string]bool)
+	if _, name, optional, ok := shared.SplitCatchAllPattern(route.Path); ok && optional {
+		optionalParams[name] = true
+	}
+
+	var rules []parameterRule
+	for _, name := range shared.ExtractRouteParamNames(route.Path) {
+		config, exists := settings.Parameters[name]
+		if !exists || config == nil {
+			continue
+		}
+
+		// Unknown types would reject every request; startup validation reports them as well
+		if !interfaces.IsValidParameterType(config.Type) {
+			pvm.logger.Error("Unsupported parameter type, rule ignored",
+				zap.String("route", route.Path),
+				zap.String("parameter", name),
+				zap.String("type", config.Type))
+			continue
+		}
+
+		rule := parameterRule{
+			name:            name,
+			supportedValues: config.SupportedValues,
+			paramType:       config.Type,
+			format:          config.Format,
+			optional:        optionalParams[name],
+		}
+
+		if config.Validation != "" {
+			// Validation patterns must match the whole parameter value
+			pattern, err := regexp.Compile("^(?:" + config.Validation + ")$")
+			if err != nil {
+				pvm.logger.Error("Invalid parameter validation pattern, rule ignored",
+					zap.String("route", route.Path),
+					zap.String("parameter", name),
+					zap.String("validation", config.Validation),
+					zap.Error(err))
+			} else {
+				rule.pattern = pattern
+			}
+		}
+
+		if rule.pattern != nil || len(rule.supportedValues) > 0 || rule.isTyped() {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// apply validates a value and converts it to the declared type
+func (rule parameterRule) apply(value string) (interface{}, error) {
+	if !rule.matches(value) {
+		return nil, fmt.Errorf("value does not satisfy validation rules")
+	}
+	return convertTypedParam(rule.paramType, rule.format, value)
+}
+
+// isTyped reports whether the rule converts the value to a non-string type
+func (rule parameterRule) isTyped() bool {
+	return rule.paramType != "" && rule.paramType != interfaces.ParameterTypeString
+}
+
+// matches checks a value against the rule; supported values take precedence over the pattern
+func (rule parameterRule) matches(value string) bool {
+	if len(rule.supportedValues) > 0 {
+		for _, supported := range rule.supportedValues {
+			if value == supported {
+				return true
+			}
+		}
+		return false
+	}
+
+	if rule.pattern == nil {
+		return true
+	}
+	return rule.pattern.MatchString(value)
+}
+
+// renderValidationError renders the nearest error template for the configured status,
+// the error service falls back to the parameter validation error page
+func (pvm *parameterValidationMiddleware) renderValidationError(w http.ResponseWriter, r *http.Request, route interfaces.Route, parameter string) {
+	statusCode := pvm.configService.GetRouterParameterValidationStatus()
+
+	info := NewRequestErrorInfo(r, statusCode, fmt.Errorf("Invalid value for parameter '%s'", parameter))
+	info.Parameter = parameter
+	component := pvm.errorService.CreateErrorInfoComponent(info, ErrorLookupPath(pvm.configService, route.TemplateFile))
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if err := component.Render(r.Context(), w); err != nil {
+		pvm.logger.Error("Failed to render parameter validation error", zap.Error(err))
+	}
+}
+
@@ -0,0 +1,261 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// mockParamErrorService records error template lookups
+type mockParamErrorService struct {
+	hasTemplate bool
+	lookupPath  string
+	info        *interfaces.ErrorInfo
+}
+
+func (m *mockParamErrorService) FindErrorTemplateForPath(path string) *interfaces.ErrorTemplate {
+	m.lookupPath = path
+	if !m.hasTemplate {
+		return nil
+	}
+	return &interfaces.ErrorTemplate{FilePath: "app" + path + "/error.templ"}
+}
+
+func (m *mockParamErrorService) CreateErrorComponent(message, path string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "error.templ: "+message)
+		return err
+	})
+}
+
+func (m *mockParamErrorService) CreateErrorInfoComponent(info *interfaces.ErrorInfo, lookupPath string) templ.Component {
+	m.lookupPath = lookupPath
+	m.info = info
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		message := info.MessageKey
+		if info.Cause != nil {
+			message += ": " + info.Cause.Error()
+		}
+		_, err := io.WriteString(w, "error.templ: "+message)
+		return err
+	})
+}
+
+func newTestParameterValidationMiddleware(errorService interfaces.ErrorService, status int) *parameterValidationMiddleware {
+	return &parameterValidationMiddleware{
+		errorService:  errorService,
+		configService: &mockRouterConfigService{parameterValidationStatus: status},
+		logger:        zap.NewNop(),
+	}
+}
+
+func serveWithParameterValidation(mw *parameterValidationMiddleware, route interfaces.Route, settings *interfaces.DynamicSettings, path string) (*httptest.ResponseRecorder, bool) {
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	r := chi.NewRouter()
+	r.Get("/{locale}/data/{userId}", mw.Handle(next, route, settings).ServeHTTP)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec, called
+}
+
+func TestParameterValidationMiddleware(t *testing.T) {
+	route := interfaces.Route{
+		Path:         "/{locale}/data/{userId}",
+		TemplateFile: "app/locale_/data/userId_/page.templ",
+	}
+	settings := &interfaces.DynamicSettings{
+		Parameters: map[string]*interfaces.DynamicParameterConfig{
+			"locale": {SupportedValues: []string{"en", "de"}},
+			"userId": {Validation: "[0-9a-z]*"},
+		},
+	}
+
+	t.Run("valid parameters reach the next handler", func(t *testing.T) {
+		mw := newTestParameterValidationMiddleware(&mockParamErrorService{}, 0)
+		rec, called := serveWithParameterValidation(mw, route, settings, "/en/data/user42")
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("pattern must match the whole value", func(t *testing.T) {
+		errorService := &mockParamErrorService{hasTemplate: true}
+		mw := newTestParameterValidationMiddleware(errorService, 0)
+		rec, called := serveWithParameterValidation(mw, route, settings, "/en/data/User-42")
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), "error.templ: error_not_found: Invalid value for parameter 'userId'")
+		assert.Equal(t, "/locale_/data/userId_", errorService.lookupPath)
+		assert.Equal(t, http.StatusNotFound, errorService.info.StatusCode)
+		assert.Equal(t, "/en/data/User-42", errorService.info.Path)
+		assert.Equal(t, "userId", errorService.info.Parameter)
+	})
+
+	t.Run("unsupported value with configured 400 and fallback page", func(t *testing.T) {
+		errorService := &mockParamErrorService{}
+		mw := newTestParameterValidationMiddleware(errorService, http.StatusBadRequest)
+		rec, called := serveWithParameterValidation(mw, route, settings, "/fr/data/user42")
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, interfaces.ErrorMessageKeyBadRequest, errorService.info.MessageKey)
+		assert.Contains(t, rec.Body.String(), "locale")
+	})
+
+	t.Run("routes without rules are not wrapped", func(t *testing.T) {
+		mw := newTestParameterValidationMiddleware(&mockParamErrorService{}, 0)
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+		handler := mw.Handle(next, route, nil)
+
+		assert.NotNil(t, handler)
+		assert.Empty(t, mw.compileRules(route, nil))
+	})
+
+	t.Run("rules with unknown types are skipped", func(t *testing.T) {
+		mw := newTestParameterValidationMiddleware(&mockParamErrorService{}, 0)
+		unknownType := &interfaces.DynamicSettings{
+			Parameters: map[string]*interfaces.DynamicParameterConfig{
+				"userId": {Type: "float"},
+			},
+		}
+
+		assert.Empty(t, mw.compileRules(route, unknownType))
+
+		rec, called := serveWithParameterValidation(mw, route, unknownType, "/en/data/user42")
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestParameterValidationMiddlewareTypedParameters(t *testing.T) {
+	route := interfaces.Route{
+		Path:         "/{locale}/data/{userId}",
+		TemplateFile: "app/locale_/data/userId_/page.templ",
+	}
+	settings := &interfaces.DynamicSettings{
+		Parameters: map[string]*interfaces.DynamicParameterConfig{
+			"locale": {Type: interfaces.ParameterTypeEnum, SupportedValues: []string{"en", "de"}},
+			"userId": {Type: interfaces.ParameterTypeInt},
+		},
+	}
+
+	serve := func(path string) (*httptest.ResponseRecorder, interfaces.RouterContext) {
+		var routerCtx interfaces.RouterContext
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			routerCtx = NewRouterContext(r.Context(), r)
+		})
+
+		mw := newTestParameterValidationMiddleware(&mockParamErrorService{}, 0)
+		r := chi.NewRouter()
+		r.Get("/{locale}/data/{userId}", mw.Handle(next, route, settings).ServeHTTP)
+
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec, routerCtx
+	}
+
+	t.Run("converted values are exposed to RouterContext", func(t *testing.T) {
+		rec, routerCtx := serve("/de/data/42")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotNil(t, routerCtx)
+
+		userID, err := routerCtx.GetURLParamInt("userId")
+		assert.NoError(t, err)
+		assert.Equal(t, 42, userID)
+
+		_, err = routerCtx.GetURLParamUUID("userId")
+		assert.Error(t, err)
+	})
+
+	t.Run("unparsable value is rejected before the handler", func(t *testing.T) {
+		rec, routerCtx := serve("/de/data/abc")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Nil(t, routerCtx)
+	})
+
+	t.Run("enum value outside supported values is rejected", func(t *testing.T) {
+		rec, routerCtx := serve("/fr/data/42")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Nil(t, routerCtx)
+	})
+}
+
+func TestConvertTypedParam(t *testing.T) {
+	tests := []struct {
+		name      string
+		paramType string
+		format    string
+		value     string
+		expected  interface{}
+		wantErr   bool
+	}{
+		{"int", interfaces.ParameterTypeInt, "", "7", 7, false},
+		{"invalid int", interfaces.ParameterTypeInt, "", "7a", nil, true},
+		{"uuid", interfaces.ParameterTypeUUID, "", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), false},
+		{"invalid uuid", interfaces.ParameterTypeUUID, "", "not-a-uuid", nil, true},
+		{"date with default layout", interfaces.ParameterTypeDate, "", "2024-02-29", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{"date with custom layout", interfaces.ParameterTypeDate, "20060102", "20240229", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{"invalid date", interfaces.ParameterTypeDate, "", "2023-02-29", nil, true},
+		{"enum stays string", interfaces.ParameterTypeEnum, "", "en", "en", false},
+		{"unknown type", "float", "", "1.5", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := convertTypedParam(tt.paramType, tt.format, tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestRouterContextTypedAccessorsWithoutDeclaredType(t *testing.T) {
+	chiCtx := chi.NewRouteContext()
+	chiCtx.URLParams.Add("id", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	chiCtx.URLParams.Add("day", "2024-01-15")
+	chiCtx.URLParams.Add("page", "3")
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx)
+	routerCtx := NewRouterContext(ctx, req.WithContext(ctx))
+
+	id, err := routerCtx.GetURLParamUUID("id")
+	assert.NoError(t, err)
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", id.String())
+
+	day, err := routerCtx.GetURLParamTime("day")
+	assert.NoError(t, err)
+	assert.Equal(t, time.January, day.Month())
+
+	page, err := routerCtx.GetURLParamInt("page")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, page)
+
+	_, err = routerCtx.GetURLParamInt("missing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+// rateLimitMiddleware answers clients that send too many requests with 429 (private implementation)
+type rateLimitMiddleware struct {
+	rateLimiter    interfaces.RateLimiter
+	sessionStore   interfaces.SessionStore
+	trustedProxies shared.TrustedProxies
+	errorService   interfaces.ErrorService
+	i18nService    interfaces.I18nService
+	configService  interfaces.ConfigService
+	logger         *zap.Logger
+}
+
+// NewRateLimitMiddleware creates a new rate limit middleware for DI
+func NewRateLimitMiddleware(i do.Injector) (RateLimitMiddlewareInterface, error) {
+	rateLimiter := do.MustInvoke[interfaces.RateLimiter](i)
+	sessionStore := do.MustInvoke[interfaces.SessionStore](i)
+	errorService := do.MustInvoke[interfaces.ErrorService](i)
+	i18nService := do.MustInvoke[interfaces.I18nService](i)
+	configService := do.MustInvoke[interfaces.ConfigService](i)
+	logger := do.MustInvoke[*zap.Logger](i)
+
+	trustedProxies, err := shared.ParseTrustedProxies(configService.GetTrustedProxies())
+	if err != nil {
+		return nil, err
+	}
+
+	return &rateLimitMiddleware{
+		rateLimiter:    rateLimiter,
+		sessionStore:   sessionStore,
+		trustedProxies: trustedProxies,
+		errorService:   errorService,
+		i18nService:    i18nService,
+		configService:  configService,
+		logger:         logger,
+	}, nil
+}
+
+// Handle limits the requests of routes with a "rate_limit" section to the budget of the route.
+// Routes without one are only limited with TR_SECURITY_RATE_LIMIT_ALL_ROUTES, then each client IP
+// may send TR_SECURITY_RATE_LIMIT_REQUESTS requests per minute to all those routes together.
+func (rlm *rateLimitMiddleware) Handle(next http.Handler, route interfaces.Route, settings *interfaces.RateLimitSettings) http.Handler {
+	if !rlm.configService.IsRateLimitEnabled() {
+		return next
+	}
+	if settings == nil && !rlm.configService.IsRateLimitAllRoutesEnabled() {
+		return next
+	}
+
+	scope := "default"
+	limit := interfaces.RateLimitSettings{
+		Requests: rlm.configService.GetRateLimitRequests(),
+		Per:      interfaces.DefaultRateLimitPeriod,
+		Key:      interfaces.RateLimitKeyIP,
+	}
+	if settings != nil {
+		scope = "route:" + route.Path
+		limit = *settings
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := scope + "|" + rlm.clientKey(w, r, limit.Key)
+
+		allowed, retryAfter := rlm.rateLimiter.Allow(key, limit.Requests, limit.Per)
+		if allowed {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rlm.logger.Warn("Rate limit exceeded",
+			zap.String("route", route.Path),
+			zap.String("method", r.Method),
+			zap.String("client_ip", rlm.trustedProxies.ClientIP(r)),
+			zap.String("key", string(limit.Key)),
+			zap.Int("requests", limit.Requests),
+			zap.Duration("per", limit.Per),
+			zap.Duration("retry_after", retryAfter))
+
+		shared.SetRetryAfter(w, retryAfter)
+		rlm.renderTooManyRequests(w, r, route)
+	})
+}
+
+// clientKey identifies whose requests share a bucket. Session keys only use sessions the
+// store knows, made up session cookies would get a fresh bucket with every request.
+func (rlm *rateLimitMiddleware) clientKey(w http.ResponseWriter, r *http.Request, key interfaces.RateLimitKey) string {
+	switch key {
+	case interfaces.RateLimitKeyRoute:
+		return "route"
+	case interfaces.RateLimitKeySession:
+		if session, err := rlm.sessionStore.GetSession(r); err == nil && session.Valid {
+			// The session store extended the session, the cookie must expire with it
+			if session.Renewed {
+				rlm.sessionStore.WriteSessionCookie(w, session)
+			}
+			return "session:" + session.ID
+		}
+	}
+	return "ip:" + rlm.trustedProxies.ClientIP(r)
+}
+
+// renderTooManyRequests renders the error template nearest to the route template with a 429.
+// The request did not pass the i18n middleware yet, so translations are set up here.
+func (rlm *rateLimitMiddleware) renderTooManyRequests(w http.ResponseWriter, r *http.Request, route interfaces.Route) {
+	info := NewRequestErrorInfo(r, http.StatusTooManyRequests, nil)
+	component := rlm.errorService.CreateErrorInfoComponent(info, ErrorLookupPath(rlm.configService, route.TemplateFile))
+	if component == nil {
+		http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+		return
+	}
+
+	ctx := r.Context()
+	if locale := rlm.i18nService.ExtractLocale(r); locale != "" {
+		ctx = context.WithValue(ctx, shared.LocaleKey, locale)
+	}
+	ctx = rlm.i18nService.CreateContext(ctx, route.TemplateFile)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusTooManyRequests)
+	if err := component.Render(ctx, w); err != nil {
+		rlm.logger.Error("Failed to render rate limit error page",
+			zap.String("route", route.Path),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// rateLimitTestConfigService enables rate limiting with a default of two requests
+type rateLimitTestConfigService struct {
+	mockRouterConfigService
+	disabled  bool
+	allRoutes bool
+}
+
+func (m *rateLimitTestConfigService) IsRateLimitEnabled() bool          { return !m.disabled }
+func (m *rateLimitTestConfigService) GetRateLimitRequests() int         { return 2 }
+func (m *rateLimitTestConfigService) IsRateLimitAllRoutesEnabled() bool { return m.allRoutes }
+
+// rateLimitTestSessionStore knows the sessions alice and bob, the session of bob was renewed
+type rateLimitTestSessionStore struct {
+	interfaces.SessionStore
+	written []string
+}
+
+func (s *rateLimitTestSessionStore) GetSession(r *http.Request) (*interfaces.Session, error) {
+	cookie, err := r.Cookie("session")
+	if err != nil || (cookie.Value != "alice" && cookie.Value != "bob") {
+		return nil, errors.New("session not found")
+	}
+	return &interfaces.Session{ID: cookie.Value, Valid: true, Renewed: cookie.Value == "bob"}, nil
+}
+
+func (s *rateLimitTestSessionStore) WriteSessionCookie(w http.ResponseWriter, session *interfaces.Session) {
+	s.written = append(s.written, session.ID)
+}
+
+// countingRateLimiter allows requests requests per key and records the limits it was asked for
+type countingRateLimiter struct {
+	counts map[string]int
+	per    time.Duration
+}
+
+func (l *countingRateLimiter) Allow(key string, requests int, per time.Duration) (bool, time.Duration) {
+	l.per = per
+	l.counts[key]++
+	if l.counts[key] > requests {
+		return false, 1500 * time.Millisecond
+	}
+	return true, 0
+}
+
+func newTestRateLimitMiddleware(config *rateLimitTestConfigService) (*rateLimitMiddleware, *countingRateLimiter) {
+	limiter := &countingRateLimiter{counts: map[string]int{}}
+	return &rateLimitMiddleware{
+		rateLimiter:   limiter,
+		sessionStore:  &rateLimitTestSessionStore{},
+		errorService:  &mockParamErrorService{},
+		i18nService:   &recoveryTestI18nService{},
+		configService: config,
+		logger:        zap.NewNop(),
+	}, limiter
+}
+
+func serveRateLimited(handler http.Handler, remoteAddr string, session string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/login", nil)
+	r.RemoteAddr = remoteAddr
+	if session != "" {
+		r.AddCookie(&http.Cookie{Name: "session", Value: session})
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, r)
+	return rec
+}
+
+var rateLimitTestRoute = interfaces.Route{Path: "/login", TemplateFile: "app/login/page.templ"}
+
+func TestRateLimitMiddlewareDefaultLimitIsOptIn(t *testing.T) {
+	rlm, limiter := newTestRateLimitMiddleware(&rateLimitTestConfigService{})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok")) })
+	handler := rlm.Handle(ok, rateLimitTestRoute, nil)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, serveRateLimited(handler, "10.0.0.1:1234", "").Code)
+	}
+	assert.Empty(t, limiter.counts)
+}
+
+func TestRateLimitMiddlewareDefaultLimit(t *testing.T) {
+	rlm, limiter := newTestRateLimitMiddleware(&rateLimitTestConfigService{allRoutes: true})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok")) })
+	handler := rlm.Handle(ok, rateLimitTestRoute, nil)
+
+	assert.Equal(t, http.StatusOK, serveRateLimited(handler, "10.0.0.1:1234", "").Code)
+	assert.Equal(t, http.StatusOK, serveRateLimited(handler, "10.0.0.1:5678", "").Code)
+
+	rec := serveRateLimited(handler, "10.0.0.1:1234", "")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+	assert.Equal(t, "error.templ: error_too_many_requests", rec.Body.String())
+	assert.Equal(t, interfaces.DefaultRateLimitPeriod, limiter.per)
+
+	// Other clients and other routes share nothing with 10.0.0.1, except the default budget
+	assert.Equal(t, http.StatusOK, serveRateLimited(handler, "10.0.0.2:1234", "").Code)
+	other := rlm.Handle(ok, interfaces.Route{Path: "/signup"}, nil)
+	assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(other, "10.0.0.1:1234", "").Code)
+}
+
+func TestRateLimitMiddlewareRouteSettings(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok")) })
+
+	t.Run("session key", func(t *testing.T) {
+		rlm, limiter := newTestRateLimitMiddleware(&rateLimitTestConfigService{})
+		settings := &interfaces.RateLimitSettings{Requests: 1, Per: time.Hour, Key: interfaces.RateLimitKeySession}
+		handler := rlm.Handle(ok, rateLimitTestRoute, settings)
+
+		assert.Equal(t, http.StatusOK, serveRateLimited(handler, "10.0.0.1:1234", "alice").Code)
+		assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(handler, "10.0.0.2:1234", "alice").Code)
+		assert.Equal(t, http.StatusOK, serveRateLimited(handler, "10.0.0.1:1234", "bob").Code)
+		assert.Equal(t, time.Hour, limiter.per)
+
+		// The renewed session of bob gets a new cookie
+		assert.Equal(t, []string{"bob"}, rlm.sessionStore.(*rateLimitTestSessionStore).written)
+
+		// Clients without a valid session are limited by IP, made up cookies do not get their own bucket
+		assert.Equal(t, http.StatusOK, serveRateLimited(handler, "10.0.0.3:1234", "").Code)
+		assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(handler, "10.0.0.3:1234", "random-1").Code)
+		assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(handler, "10.0.0.3:1234", "random-2").Code)
+		assert.Contains(t, limiter.counts, "route:/login|ip:10.0.0.3")
+	})
+
+	t.Run("trusted proxy", func(t *testing.T) {
+		rlm, limiter := newTestRateLimitMiddleware(&rateLimitTestConfigService{})
+		proxies, err := shared.ParseTrustedProxies([]string{"192.168.0.0/16"})
+		require.NoError(t, err)
+		rlm.trustedProxies = proxies
+		handler := rlm.Handle(ok, rateLimitTestRoute, &interfaces.RateLimitSettings{Requests: 1, Per: time.Minute})
+
+		serve := func(remoteAddr, forwardedFor string) int {
+			r := httptest.NewRequest(http.MethodGet, "/login", nil)
+			r.RemoteAddr = remoteAddr
+			r.Header.Set("X-Forwarded-For", forwardedFor)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, r)
+			return rec.Code
+		}
+
+		// Clients behind the proxy have their own buckets
+		assert.Equal(t, http.StatusOK, serve("192.168.1.1:1234", "10.0.0.1"))
+		assert.Equal(t, http.StatusOK, serve("192.168.1.1:1234", "10.0.0.2"))
+		assert.Equal(t, http.StatusTooManyRequests, serve("192.168.1.1:1234", "10.0.0.1"))
+
+		// Untrusted clients cannot choose their address
+		assert.Equal(t, http.StatusOK, serve("10.0.0.9:1234", "10.0.0.7"))
+		assert.Equal(t, http.StatusTooManyRequests, serve("10.0.0.9:1234", "10.0.0.8"))
+		assert.Contains(t, limiter.counts, "route:/login|ip:10.0.0.9")
+	})
+
+	t.Run("route key", func(t *testing.T) {
+		rlm, _ := newTestRateLimitMiddleware(&rateLimitTestConfigService{})
+		settings := &interfaces.RateLimitSettings{Requests: 1, Per: time.Minute, Key: interfaces.RateLimitKeyRoute}
+		handler := rlm.Handle(ok, rateLimitTestRoute, settings)
+
+		assert.Equal(t, http.StatusOK, serveRateLimited(handler, "10.0.0.1:1234", "").Code)
+		assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(handler, "10.0.0.2:1234", "").Code)
+	})
+}
+
+func TestRateLimitMiddlewareDisabled(t *testing.T) {
+	rlm, limiter := newTestRateLimitMiddleware(&rateLimitTestConfigService{disabled: true, allRoutes: true})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok")) })
+	handler := rlm.Handle(ok, rateLimitTestRoute, &interfaces.RateLimitSettings{Requests: 1, Per: time.Minute})
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, serveRateLimited(handler, "10.0.0.1:1234", "").Code)
+	}
+	assert.Empty(t, limiter.counts)
+}
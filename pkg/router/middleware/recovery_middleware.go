@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+// recoveryMiddleware turns panics in the route pipeline into error pages (private implementation)
+type recoveryMiddleware struct {
+	errorService  interfaces.ErrorService
+	i18nService   interfaces.I18nService
+	layoutService interfaces.LayoutService
+	configService interfaces.ConfigService
+	logger        *zap.Logger
+}
+
+// NewRecoveryMiddleware creates a new recovery middleware for DI
+func NewRecoveryMiddleware(i do.Injector) (RecoveryMiddlewareInterface, error) {
+	errorService := do.MustInvoke[interfaces.ErrorService](i)
+	i18nService := do.MustInvoke[interfaces.I18nService](i)
+	layoutService := do.MustInvoke[interfaces.LayoutService](i)
+	configService := do.MustInvoke[interfaces.ConfigService](i)
+	logger := do.MustInvoke[*zap.Logger](i)
+
+	return &recoveryMiddleware{
+		errorService:  errorService,
+		i18nService:   i18nService,
+		layoutService: layoutService,
+		configService: configService,
+		logger:        logger,
+	}, nil
+}
+
+// Handle recovers panics of next, logs them and renders the nearest error template with a 500
+func (rm *recoveryMiddleware) Handle(next http.Handler, route interfaces.Route) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &recoveryResponseWriter{ResponseWriter: w}
+
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			// http.ErrAbortHandler aborts the response on purpose and must reach net/http
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			stack := debug.Stack()
+			rm.logger.Error("Panic while handling route",
+				zap.String("route", route.Path),
+				zap.String("template", route.TemplateFile),
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Any("panic", recovered),
+				zap.ByteString("stack", stack))
+
+			// A started response cannot be replaced by an error page
+			if rw.wroteHeader {
+				return
+			}
+			rm.renderPanic(w, r, route, panicError(recovered), stack)
+		}()
+
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// renderPanic renders the error template nearest to the route template with a 500.
+// In development the diagnostics overlay with the stack trace is rendered on top of it.
+func (rm *recoveryMiddleware) renderPanic(w http.ResponseWriter, r *http.Request, route interfaces.Route, cause error, stack []byte) {
+	info := NewRequestErrorInfo(r, http.StatusInternalServerError, cause)
+	component := rm.errorService.CreateErrorInfoComponent(info, ErrorLookupPath(rm.configService, route.TemplateFile))
+
+	if rm.configService.IsDevelopment() {
+		diagnostics := newDevErrorDiagnostics(rm.configService, r, route, http.StatusInternalServerError, cause,
+			rm.layoutService.FindLayoutForTemplate(route.TemplateFile))
+		diagnostics.Stack = string(stack)
+		component = withDevErrorOverlay(component, diagnostics)
+	}
+
+	// The panic may have happened before the i18n middleware set up the context
+	ctx := r.Context()
+	if locale := rm.i18nService.ExtractLocale(r); locale != "" {
+		ctx = context.WithValue(ctx, shared.LocaleKey, locale)
+	}
+	ctx = rm.i18nService.CreateContext(ctx, route.TemplateFile)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusInternalServerError)
+	if err := component.Render(ctx, w); err != nil {
+		rm.logger.Error("Failed to render panic error page",
+			zap.String("route", route.Path),
+			zap.Error(err))
+	}
+}
+
+// panicError converts a recovered value to the error cause of the error page
+func panicError(recovered interface{}) error {
+	if err, ok := recovered.(error); ok {
+		return fmt.Errorf("panic: %w", err)
+	}
+	return fmt.Errorf("panic: %v", recovered)
+}
+
+// recoveryResponseWriter records whether the response was started
+type recoveryResponseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (rw *recoveryResponseWriter) WriteHeader(status int) {
+	rw.wroteHeader = true
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *recoveryResponseWriter) Write(b []byte) (int, error) {
+	rw.wroteHeader = true
+	return rw.ResponseWriter.Write(b)
+}
+
+// Flush keeps streaming renders working through the wrapper
+func (rw *recoveryResponseWriter) Flush() {
+	if flusher, ok := rw.ResponseWriter.(http.Flusher); ok {
+		rw.wroteHeader = true
+		flusher.Flush()
+	}
+}
+
+// Unwrap exposes the original writer to http.ResponseController
+func (rw *recoveryResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
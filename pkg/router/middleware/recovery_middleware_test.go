@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// recoveryTestI18nService records the template the error page context was created for
+type recoveryTestI18nService struct {
+	templatePath string
+}
+
+func (m *recoveryTestI18nService) ExtractLocale(req *http.Request) string { return "en" }
+func (m *recoveryTestI18nService) GetSupportedLocales() []string          { return []string{"en"} }
+func (m *recoveryTestI18nService) LoadAllTranslations(templatePaths []string) error {
+	return nil
+}
+func (m *recoveryTestI18nService) CreateContext(ctx context.Context, templatePath string) context.Context {
+	m.templatePath = templatePath
+	return ctx
+}
+
+// recoveryTestLayoutService wraps every page in app/layout.templ
+type recoveryTestLayoutService struct{}
+
+func (m *recoveryTestLayoutService) FindLayoutForTemplate(templatePath string) *interfaces.LayoutTemplate {
+	return &interfaces.LayoutTemplate{FilePath: "app/layout.templ"}
+}
+func (m *recoveryTestLayoutService) FindNamedLayout(name string) *interfaces.LayoutTemplate {
+	return nil
+}
+func (m *recoveryTestLayoutService) WrapInLayout(component templ.Component, layout *interfaces.LayoutTemplate, ctx context.Context) templ.Component {
+	return component
+}
+
+func newTestRecoveryMiddleware(t *testing.T, errorService interfaces.ErrorService, i18nService interfaces.I18nService, production bool) RecoveryMiddlewareInterface {
+	injector := do.New()
+	t.Cleanup(func() { _ = injector.Shutdown() })
+
+	do.ProvideValue[interfaces.ErrorService](injector, errorService)
+	do.ProvideValue[interfaces.I18nService](injector, i18nService)
+	do.ProvideValue[interfaces.LayoutService](injector, &recoveryTestLayoutService{})
+	do.ProvideValue[interfaces.ConfigService](injector, &errorTestConfigService{
+		layoutTestConfigService: layoutTestConfigService{rootDir: "app"},
+		production:              production,
+	})
+	do.ProvideValue(injector, zap.NewNop())
+
+	mw, err := NewRecoveryMiddleware(injector)
+	require.NoError(t, err)
+	return mw
+}
+
+func TestRecoveryMiddlewareRendersNearestErrorTemplate(t *testing.T) {
+	route := interfaces.Route{Path: "/{locale}/dashboard", TemplateFile: "app/locale_/dashboard/page.templ"}
+	panicking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("data service exploded")
+	})
+
+	tests := []struct {
+		name          string
+		production    bool
+		expectOverlay bool
+	}{
+		{name: "development shows the stack trace overlay", expectOverlay: true},
+		{name: "production hides the stack trace", production: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errorService := &mockParamErrorService{}
+			i18nService := &recoveryTestI18nService{}
+			mw := newTestRecoveryMiddleware(t, errorService, i18nService, tt.production)
+
+			rec := httptest.NewRecorder()
+			mw.Handle(panicking, route).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/en/dashboard", nil))
+
+			assert.Equal(t, http.StatusInternalServerError, rec.Code)
+			assert.Equal(t, "/locale_/dashboard", errorService.lookupPath)
+			assert.Equal(t, route.TemplateFile, i18nService.templatePath)
+			require.NotNil(t, errorService.info)
+			assert.Equal(t, http.StatusInternalServerError, errorService.info.StatusCode)
+			assert.Contains(t, rec.Body.String(), "error.templ: "+interfaces.ErrorMessageKeyInternal)
+
+			body := rec.Body.String()
+			if tt.expectOverlay {
+				assert.Contains(t, body, "templ-router-dev-error")
+				assert.Contains(t, body, "data service exploded")
+				assert.Contains(t, body, "runtime/debug.Stack")
+				assert.Contains(t, body, "app/layout.templ")
+			} else {
+				assert.NotContains(t, body, "templ-router-dev-error")
+			}
+		})
+	}
+}
+
+func TestRecoveryMiddlewareKeepsStartedResponse(t *testing.T) {
+	errorService := &mockParamErrorService{}
+	mw := newTestRecoveryMiddleware(t, errorService, &recoveryTestI18nService{}, false)
+
+	handler := mw.Handle(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("<html>partial"))
+		panic("streaming failed")
+	}), interfaces.Route{Path: "/", TemplateFile: "app/page.templ"})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "<html>partial", rec.Body.String())
+	assert.Nil(t, errorService.info, "no error page for a started response")
+}
+
+func TestRecoveryMiddlewareRepanicsAbortHandler(t *testing.T) {
+	mw := newTestRecoveryMiddleware(t, &mockParamErrorService{}, &recoveryTestI18nService{}, false)
+
+	handler := mw.Handle(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}), interfaces.Route{Path: "/", TemplateFile: "app/page.templ"})
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
+
+func TestPanicError(t *testing.T) {
+	cause := shared.NewTemplateError("broken")
+	assert.ErrorIs(t, panicError(cause), cause)
+	assert.EqualError(t, panicError(42), "panic: 42")
+}
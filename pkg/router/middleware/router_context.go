@@ -4,9 +4,14 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/adapter"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 // routerContext is the concrete implementation of RouterContext
@@ -22,13 +27,14 @@ type routerContext struct {
 
 // NewRouterContext creates a new RouterContext instance
 func NewRouterContext(ctx context.Context, req *http.Request) interfaces.RouterContext {
-	chiCtx := chi.RouteContext(ctx)
-	
+	// Path values are read through the adapter that routed the request
+	paramReq := req.WithContext(ctx)
+
 	return &routerContext{
 		ctx:        ctx,
 		request:    req,
-		chiContext: chiCtx,
-		urlParams:  extractURLParams(chiCtx),
+		chiContext: chi.RouteContext(ctx),
+		urlParams:  adapter.FromRequest(paramReq).URLParams(paramReq),
 		queryParams: req.URL.Query(),
 	}
 }
@@ -59,6 +65,90 @@ func (rc *routerContext) GetAllURLParams() map[string]string {
 	return result
 }
 
+// GetURLParamSegments returns a URL parameter split into its path segments
+func (rc *routerContext) GetURLParamSegments(key string) []string {
+	value := strings.Trim(rc.GetURLParam(key), "/")
+	if value == "" {
+		return []string{}
+	}
+
+	segments := []string{}
+	for _, segment := range strings.Split(value, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// GetURLParamInt returns a URL parameter as int
+func (rc *routerContext) GetURLParamInt(key string) (int, error) {
+	value, err := rc.typedURLParam(key, interfaces.ParameterTypeInt)
+	if err != nil {
+		return 0, err
+	}
+	return value.(int), nil
+}
+
+// GetURLParamUUID returns a URL parameter as uuid.UUID
+func (rc *routerContext) GetURLParamUUID(key string) (uuid.UUID, error) {
+	value, err := rc.typedURLParam(key, interfaces.ParameterTypeUUID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return value.(uuid.UUID), nil
+}
+
+// GetURLParamTime returns a date URL parameter as time.Time
+func (rc *routerContext) GetURLParamTime(key string) (time.Time, error) {
+	value, err := rc.typedURLParam(key, interfaces.ParameterTypeDate)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return value.(time.Time), nil
+}
+
+// typedURLParam returns the value converted by the parameter validation middleware,
+// falling back to parsing the raw value when the parameter has no declared type
+func (rc *routerContext) typedURLParam(key, paramType string) (interface{}, error) {
+	if typed, ok := rc.ctx.Value(shared.TypedURLParamsKey).(map[string]interface{}); ok {
+		if value, exists := typed[key]; exists {
+			switch paramType {
+			case interfaces.ParameterTypeInt:
+				if _, ok := value.(int); ok {
+					return value, nil
+				}
+			case interfaces.ParameterTypeUUID:
+				if _, ok := value.(uuid.UUID); ok {
+					return value, nil
+				}
+			case interfaces.ParameterTypeDate:
+				if _, ok := value.(time.Time); ok {
+					return value, nil
+				}
+			}
+			return nil, shared.NewValidationError("URL parameter has a different declared type").
+				WithContext("parameter", key).
+				WithContext("requested_type", paramType)
+		}
+	}
+
+	raw := rc.GetURLParam(key)
+	if raw == "" {
+		return nil, shared.NewValidationError("URL parameter not found").
+			WithContext("parameter", key)
+	}
+
+	value, err := convertTypedParam(paramType, "", raw)
+	if err != nil {
+		return nil, shared.NewValidationError("Invalid URL parameter value").
+			WithCause(err).
+			WithContext("parameter", key).
+			WithContext("type", paramType)
+	}
+	return value, nil
+}
+
 // GetQueryParam returns the first value for a query parameter
 func (rc *routerContext) GetQueryParam(key string) string {
 	if rc.queryParams == nil {
@@ -94,25 +184,7 @@ func (rc *routerContext) Request() *http.Request {
 	return rc.request
 }
 
-// ChiContext returns the Chi router context
+// ChiContext returns the Chi router context (nil when routed by another adapter)
 func (rc *routerContext) ChiContext() *chi.Context {
 	return rc.chiContext
 }
-
-// extractURLParams extracts URL parameters from Chi context
-func extractURLParams(chiCtx *chi.Context) map[string]string {
-	if chiCtx == nil {
-		return make(map[string]string)
-	}
-	
-	params := make(map[string]string)
-	for i, key := range chiCtx.URLParams.Keys {
-		if i < len(chiCtx.URLParams.Values) {
-			value := chiCtx.URLParams.Values[i]
-			if value != "" {
-				params[key] = value
-			}
-		}
-	}
-	return params
-}
\ No newline at end of file
@@ -1,8 +1,11 @@
 package middleware
 
 import (
+	"net/http"
+	"path"
+	"strings"
+
 	"github.com/denkhaus/templ-router/pkg/interfaces"
-	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
@@ -11,8 +14,10 @@ import (
 
 // routerMiddleware handles router-level middleware configuration (private implementation)
 type routerMiddleware struct {
-	configService interfaces.ConfigService
-	logger        *zap.Logger
+	configService             interfaces.ConfigService
+	csrfMiddleware            CSRFMiddlewareInterface
+	securityHeadersMiddleware SecurityHeadersMiddlewareInterface
+	logger                    *zap.Logger
 }
 
 // NewRouterMiddleware creates a new router middleware for DI
@@ -20,28 +25,73 @@ func NewRouterMiddleware(i do.Injector) (RouterMiddlewareInterface, error) {
 	configService := do.MustInvoke[interfaces.ConfigService](i)
 	logger := do.MustInvoke[*zap.Logger](i)
 
+	// The CSRF middleware and its error rendering are only needed when CSRF protection is on
+	var csrfMiddleware CSRFMiddlewareInterface
+	if configService.IsCSRFEnabled() {
+		csrfMiddleware = do.MustInvoke[CSRFMiddlewareInterface](i)
+	}
+
+	var securityHeadersMiddleware SecurityHeadersMiddlewareInterface
+	if configService.AreSecurityHeadersEnabled() {
+		securityHeadersMiddleware = do.MustInvoke[SecurityHeadersMiddlewareInterface](i)
+	}
+
 	return &routerMiddleware{
-		configService: configService,
-		logger:        logger,
+		configService:             configService,
+		csrfMiddleware:            csrfMiddleware,
+		securityHeadersMiddleware: securityHeadersMiddleware,
+		logger:                    logger,
 	}, nil
 }
 
-// ConfigureRouterMiddleware configures router-level middleware based on configuration
-func (rm *routerMiddleware) ConfigureRouterMiddleware(chiRouter *chi.Mux) error {
+// ConfigureRouterMiddleware wraps the router handler (a chi router, a router adapter or
+// any other http.Handler) with the router-level middleware enabled in the configuration
+func (rm *routerMiddleware) ConfigureRouterMiddleware(next http.Handler) (http.Handler, error) {
 	rm.logger.Debug("Configuring router middleware")
 
+	handler := next
+
+	// Configure CSRF protection for all routes, including handlers registered outside the router
+	if rm.csrfMiddleware != nil {
+		handler = rm.csrfMiddleware.Handle(handler)
+		rm.logger.Info("Enabled CSRF protection")
+	}
+
+	// Configure slash redirection (clean path); runs after the trailing slash redirect
+	if rm.configService.GetRouterEnableSlashRedirect() {
+		handler = cleanPath(handler)
+		rm.logger.Info("Enabled slash redirection")
+	}
+
 	// Configure trailing slash redirection
 	if rm.configService.GetRouterEnableTrailingSlash() {
-		chiRouter.Use(chimiddleware.RedirectSlashes)
+		handler = chimiddleware.RedirectSlashes(handler)
 		rm.logger.Info("Enabled trailing slash redirection")
 	}
 
-	// Configure slash redirection (clean path)
-	if rm.configService.GetRouterEnableSlashRedirect() {
-		chiRouter.Use(chimiddleware.CleanPath)
-		rm.logger.Info("Enabled slash redirection")
+	// Configure security headers last, so they are set on every response, including redirects
+	// and CSRF errors, and the CSP nonce is in the context of all other middleware
+	if rm.securityHeadersMiddleware != nil {
+		handler = rm.securityHeadersMiddleware.Handle(handler)
+		rm.logger.Info("Enabled security headers")
 	}
 
 	rm.logger.Debug("Router middleware configuration completed")
-	return nil
-}
\ No newline at end of file
+	return handler, nil
+}
+
+// cleanPath routes double slash mistakes like /users//1 as /users/1. Unlike chi's CleanPath
+// it rewrites the request URL, so it also works in front of routers without a chi route context.
+func cleanPath(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "//") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cleaned := r.Clone(r.Context())
+		cleaned.URL.Path = path.Clean(r.URL.Path)
+		cleaned.URL.RawPath = ""
+		next.ServeHTTP(w, cleaned)
+	})
+}
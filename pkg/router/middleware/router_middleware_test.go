@@ -19,11 +19,25 @@ type mockRouterConfigService struct {
 	enableTrailingSlash    bool
 	enableSlashRedirect    bool
 	enableMethodNotAllowed bool
+	parameterValidationStatus int
+	enableCSRF             bool
+	csrfExemptPaths        []string
+	basePath               string
 }
 
 func (m *mockRouterConfigService) GetRouterEnableTrailingSlash() bool     { return m.enableTrailingSlash }
 func (m *mockRouterConfigService) GetRouterEnableSlashRedirect() bool     { return m.enableSlashRedirect }
 func (m *mockRouterConfigService) GetRouterEnableMethodNotAllowed() bool  { return m.enableMethodNotAllowed }
+func (m *mockRouterConfigService) GetRouterParameterValidationStatus() int {
+	if m.parameterValidationStatus == 0 {
+		return 404
+	}
+	return m.parameterValidationStatus
+}
+
+func (m *mockRouterConfigService) GetRouterValidationMode() string { return "warn" }
+func (m *mockRouterConfigService) GetRouterBasePath() string { return m.basePath }
+func (m *mockRouterConfigService) GetRouterRenderMode() string { return "direct" }
 
 // Implement all required ConfigService methods (minimal implementation for tests)
 func (m *mockRouterConfigService) GetLayoutRootDirectory() string            { return "app" }
@@ -57,6 +71,7 @@ func (m *mockRouterConfigService) IsEmailVerificationRequired() bool         { r
 func (m *mockRouterConfigService) GetVerificationTokenExpiry() time.Duration { return 24 * time.Hour }
 func (m *mockRouterConfigService) GetSessionCookieName() string              { return "session" }
 func (m *mockRouterConfigService) GetSessionExpiry() time.Duration           { return 24 * time.Hour }
+func (m *mockRouterConfigService) GetSessionRememberExpiry() time.Duration   { return 30 * 24 * time.Hour }
 func (m *mockRouterConfigService) IsSessionSecure() bool                     { return false }
 func (m *mockRouterConfigService) IsSessionHttpOnly() bool                   { return true }
 func (m *mockRouterConfigService) GetSessionSameSite() string                { return "Lax" }
@@ -71,15 +86,20 @@ func (m *mockRouterConfigService) GetSignInRoute() string                    { r
 func (m *mockRouterConfigService) GetSignInSuccessRoute() string             { return "/dashboard" }
 func (m *mockRouterConfigService) GetSignUpSuccessRoute() string             { return "/welcome" }
 func (m *mockRouterConfigService) GetSignOutSuccessRoute() string            { return "/" }
+func (m *mockRouterConfigService) IsCSRFEnabled() bool                       { return m.enableCSRF }
 func (m *mockRouterConfigService) GetCSRFSecret() string                     { return "secret" }
 func (m *mockRouterConfigService) IsCSRFSecure() bool                        { return false }
 func (m *mockRouterConfigService) IsCSRFHttpOnly() bool                      { return true }
 func (m *mockRouterConfigService) GetCSRFSameSite() string                   { return "Lax" }
+func (m *mockRouterConfigService) GetCSRFExemptPaths() []string              { return m.csrfExemptPaths }
 func (m *mockRouterConfigService) IsRateLimitEnabled() bool                  { return false }
 func (m *mockRouterConfigService) GetRateLimitRequests() int                 { return 100 }
+func (m *mockRouterConfigService) IsRateLimitAllRoutesEnabled() bool         { return false }
+func (m *mockRouterConfigService) GetTrustedProxies() []string               { return nil }
 func (m *mockRouterConfigService) AreSecurityHeadersEnabled() bool           { return false }
 func (m *mockRouterConfigService) IsHSTSEnabled() bool                       { return false }
 func (m *mockRouterConfigService) GetHSTSMaxAge() int                        { return 31536000 }
+func (m *mockRouterConfigService) GetContentSecurityPolicy() string          { return "" }
 func (m *mockRouterConfigService) GetLogLevel() string                       { return "info" }
 func (m *mockRouterConfigService) GetLogFormat() string                      { return "json" }
 func (m *mockRouterConfigService) GetLogOutput() string                      { return "stdout" }
@@ -145,7 +165,7 @@ func TestRouterMiddleware_ConfigureRouterMiddleware_TrailingSlashEnabled(t *test
 	router := chi.NewRouter()
 
 	// Configure router middleware BEFORE adding routes
-	err = middleware.ConfigureRouterMiddleware(router)
+	handler, err := middleware.ConfigureRouterMiddleware(router)
 	require.NoError(t, err)
 
 	// Add a test route AFTER middleware configuration
@@ -158,7 +178,7 @@ func TestRouterMiddleware_ConfigureRouterMiddleware_TrailingSlashEnabled(t *test
 	// Request to /test/ should redirect to /test
 	req := httptest.NewRequest("GET", "/test/", nil)
 	w := httptest.NewRecorder()
-	router.ServeHTTP(w, req)
+	handler.ServeHTTP(w, req)
 
 	// Should redirect (301 or 302)
 	assert.True(t, w.Code == http.StatusMovedPermanently || w.Code == http.StatusFound,
@@ -195,7 +215,7 @@ func TestRouterMiddleware_ConfigureRouterMiddleware_SlashRedirectEnabled(t *test
 	router := chi.NewRouter()
 
 	// Configure router middleware BEFORE adding routes
-	err = middleware.ConfigureRouterMiddleware(router)
+	handler, err := middleware.ConfigureRouterMiddleware(router)
 	require.NoError(t, err)
 
 	// Add a test route AFTER middleware configuration
@@ -215,7 +235,7 @@ func TestRouterMiddleware_ConfigureRouterMiddleware_SlashRedirectEnabled(t *test
 	// Request to /test//path should be cleaned to /test/path
 	req := httptest.NewRequest("GET", "/test//path", nil)
 	w := httptest.NewRecorder()
-	router.ServeHTTP(w, req)
+	handler.ServeHTTP(w, req)
 
 	// CleanPath middleware should clean the path and find the route
 	// If CleanPath is working, it should either redirect or serve the cleaned route
@@ -249,7 +269,7 @@ func TestRouterMiddleware_ConfigureRouterMiddleware_BothDisabled(t *testing.T) {
 	router := chi.NewRouter()
 
 	// Configure router middleware BEFORE adding routes
-	err = middleware.ConfigureRouterMiddleware(router)
+	handler, err := middleware.ConfigureRouterMiddleware(router)
 	require.NoError(t, err)
 
 	// Add a test route AFTER middleware configuration
@@ -262,7 +282,7 @@ func TestRouterMiddleware_ConfigureRouterMiddleware_BothDisabled(t *testing.T) {
 	// Test that no redirection happens
 	req := httptest.NewRequest("GET", "/test/", nil)
 	w := httptest.NewRecorder()
-	router.ServeHTTP(w, req)
+	handler.ServeHTTP(w, req)
 
 	// Should return 404 since /test/ is not registered and no redirection middleware is active
 	assert.Equal(t, http.StatusNotFound, w.Code,
@@ -295,7 +315,7 @@ func TestRouterMiddleware_ConfigureRouterMiddleware_BothEnabled(t *testing.T) {
 	router := chi.NewRouter()
 
 	// Configure router middleware BEFORE adding routes
-	err = middleware.ConfigureRouterMiddleware(router)
+	handler, err := middleware.ConfigureRouterMiddleware(router)
 	require.NoError(t, err)
 
 	// Add a test route AFTER middleware configuration
@@ -308,9 +328,86 @@ func TestRouterMiddleware_ConfigureRouterMiddleware_BothEnabled(t *testing.T) {
 	// Test that both middleware work together
 	req := httptest.NewRequest("GET", "/test//", nil) // Double slash + trailing slash
 	w := httptest.NewRecorder()
-	router.ServeHTTP(w, req)
+	handler.ServeHTTP(w, req)
 
 	// Should redirect to cleaned path
 	assert.True(t, w.Code == http.StatusMovedPermanently || w.Code == http.StatusFound,
 		"Expected redirect status when both middleware are enabled, got %d", w.Code)
-}
\ No newline at end of file
+}
+func TestRouterMiddleware_ConfigureRouterMiddleware_ServeMux(t *testing.T) {
+	injector := do.New()
+	defer injector.Shutdown()
+
+	do.Provide(injector, func(i do.Injector) (interfaces.ConfigService, error) {
+		return &mockRouterConfigService{
+			enableTrailingSlash: true,
+			enableSlashRedirect: true,
+		}, nil
+	})
+	do.Provide(injector, func(i do.Injector) (*zap.Logger, error) {
+		return zap.NewNop(), nil
+	})
+
+	middleware, err := NewRouterMiddleware(injector)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test/path", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	})
+
+	handler, err := middleware.ConfigureRouterMiddleware(mux)
+	require.NoError(t, err)
+
+	// Double slashes are routed as the cleaned path instead of ServeMux's own redirect
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/test//path", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "/test/path", w.Body.String())
+
+	// Trailing slashes are redirected
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/test/path/", nil))
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/test/path", w.Header().Get("Location"))
+}
+
+func TestRouterMiddleware_ConfigureRouterMiddleware_CSRFEnabled(t *testing.T) {
+	// Setup DI container
+	injector := do.New()
+	defer injector.Shutdown()
+
+	// Register dependencies with CSRF protection enabled
+	do.Provide(injector, func(i do.Injector) (interfaces.ConfigService, error) {
+		return &mockRouterConfigService{enableCSRF: true}, nil
+	})
+	do.Provide(injector, func(i do.Injector) (interfaces.ErrorService, error) {
+		return &mockParamErrorService{}, nil
+	})
+	do.Provide(injector, func(i do.Injector) (interfaces.I18nService, error) {
+		return &recoveryTestI18nService{}, nil
+	})
+	do.Provide(injector, func(i do.Injector) (*zap.Logger, error) {
+		return zap.NewNop(), nil
+	})
+	do.Provide(injector, NewCSRFMiddleware)
+
+	// Create middleware
+	middleware, err := NewRouterMiddleware(injector)
+	require.NoError(t, err)
+
+	// Handlers registered outside the router, like the auth API, are protected as well
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/auth/signout", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler, err := middleware.ConfigureRouterMiddleware(mux)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/api/auth/signout", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+// cspNonceBytes is the size of the random CSP nonce of a request
+const cspNonceBytes = 16
+
+// cspNoncePlaceholder is replaced with the nonce of the request in a Content-Security-Policy
+const cspNoncePlaceholder = "{nonce}"
+
+// securityHeadersMiddleware sets the security headers of responses (private implementation)
+type securityHeadersMiddleware struct {
+	configService interfaces.ConfigService
+	logger        *zap.Logger
+}
+
+// NewSecurityHeadersMiddleware creates a new security headers middleware for DI
+func NewSecurityHeadersMiddleware(i do.Injector) (SecurityHeadersMiddlewareInterface, error) {
+	configService := do.MustInvoke[interfaces.ConfigService](i)
+	logger := do.MustInvoke[*zap.Logger](i)
+
+	return &securityHeadersMiddleware{
+		configService: configService,
+		logger:        logger,
+	}, nil
+}
+
+// Handle sets X-Content-Type-Options, Referrer-Policy, X-Frame-Options: DENY, HSTS (if enabled) and the
+// configured Content-Security-Policy. The CSP nonce of the request is stored with templ.WithNonce,
+// so templ scripts get it and layouts can read it with router.CSPNonce(ctx).
+func (shm *securityHeadersMiddleware) Handle(next http.Handler) http.Handler {
+	policy := shm.configService.GetContentSecurityPolicy()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nonce, err := newCSPNonce()
+		if err != nil {
+			shm.logger.Error("Failed to create CSP nonce", zap.Error(err))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		header := w.Header()
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("Referrer-Policy", "strict-origin-when-cross-origin")
+		header.Set("X-Frame-Options", "DENY")
+		if shm.configService.IsHSTSEnabled() {
+			header.Set("Strict-Transport-Security", "max-age="+strconv.Itoa(shm.configService.GetHSTSMaxAge()))
+		}
+		if policy != "" {
+			header.Set("Content-Security-Policy", formatContentSecurityPolicy(policy, nonce))
+		}
+
+		next.ServeHTTP(w, r.WithContext(templ.WithNonce(r.Context(), nonce)))
+	})
+}
+
+// HandleRoute replaces the Content-Security-Policy and X-Frame-Options headers with the
+// values of the route's "security" section, the policy uses the nonce of the request
+func (shm *securityHeadersMiddleware) HandleRoute(next http.Handler, route interfaces.Route, settings *interfaces.SecuritySettings) http.Handler {
+	if !shm.configService.AreSecurityHeadersEnabled() || settings == nil ||
+		(settings.ContentSecurityPolicy == "" && settings.FrameOptions == "") {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if settings.FrameOptions == interfaces.FrameOptionsNone {
+			w.Header().Del("X-Frame-Options")
+		} else if settings.FrameOptions != "" {
+			w.Header().Set("X-Frame-Options", string(settings.FrameOptions))
+		}
+
+		if settings.ContentSecurityPolicy != "" {
+			nonce := templ.GetNonce(r.Context())
+			if nonce == "" {
+				// The router middleware does not wrap this router
+				var err error
+				if nonce, err = newCSPNonce(); err != nil {
+					shm.logger.Error("Failed to create CSP nonce",
+						zap.String("route", route.Path),
+						zap.Error(err))
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+					return
+				}
+				r = r.WithContext(templ.WithNonce(r.Context(), nonce))
+			}
+
+			w.Header().Set("Content-Security-Policy", formatContentSecurityPolicy(settings.ContentSecurityPolicy, nonce))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// newCSPNonce creates a random nonce for a single response
+func newCSPNonce() (string, error) {
+	nonce := make([]byte, cspNonceBytes)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(nonce), nil
+}
+
+// formatContentSecurityPolicy fills the {nonce} placeholders of policy,
+// e.g. "script-src 'self' 'nonce-{nonce}'"
+func formatContentSecurityPolicy(policy, nonce string) string {
+	return strings.ReplaceAll(policy, cspNoncePlaceholder, nonce)
+}
@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// securityHeadersTestConfigService enables security headers with a CSP and optional HSTS
+type securityHeadersTestConfigService struct {
+	mockRouterConfigService
+	hsts bool
+}
+
+func (m *securityHeadersTestConfigService) AreSecurityHeadersEnabled() bool { return true }
+func (m *securityHeadersTestConfigService) IsHSTSEnabled() bool             { return m.hsts }
+func (m *securityHeadersTestConfigService) GetContentSecurityPolicy() string {
+	return "default-src 'self'; script-src 'self' 'nonce-{nonce}'"
+}
+
+func newTestSecurityHeadersMiddleware(hsts bool) *securityHeadersMiddleware {
+	return &securityHeadersMiddleware{
+		configService: &securityHeadersTestConfigService{hsts: hsts},
+		logger:        zap.NewNop(),
+	}
+}
+
+// nonceHandler writes the CSP nonce of its request context
+var nonceHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(templ.GetNonce(r.Context())))
+})
+
+func TestSecurityHeadersMiddlewareSetsHeaders(t *testing.T) {
+	shm := newTestSecurityHeadersMiddleware(false)
+
+	rec := httptest.NewRecorder()
+	shm.Handle(nonceHandler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	nonce := rec.Body.String()
+	assert.NotEmpty(t, nonce)
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "strict-origin-when-cross-origin", rec.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+	assert.Empty(t, rec.Header().Get("Strict-Transport-Security"))
+	assert.Equal(t, "default-src 'self'; script-src 'self' 'nonce-"+nonce+"'", rec.Header().Get("Content-Security-Policy"))
+
+	// Every request gets its own nonce
+	rec2 := httptest.NewRecorder()
+	shm.Handle(nonceHandler).ServeHTTP(rec2, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.NotEqual(t, nonce, rec2.Body.String())
+}
+
+func TestSecurityHeadersMiddlewareSetsHSTS(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestSecurityHeadersMiddleware(true).Handle(nonceHandler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, "max-age=31536000", rec.Header().Get("Strict-Transport-Security"))
+}
+
+func TestSecurityHeadersMiddlewareRoutePolicy(t *testing.T) {
+	shm := newTestSecurityHeadersMiddleware(false)
+	route := interfaces.Route{Path: "/embed", TemplateFile: "app/embed/page.templ"}
+	settings := &interfaces.SecuritySettings{ContentSecurityPolicy: "frame-ancestors https://example.com; script-src 'nonce-{nonce}'"}
+
+	// The route policy uses the nonce of the router middleware
+	rec := httptest.NewRecorder()
+	shm.Handle(shm.HandleRoute(nonceHandler, route, settings)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/embed", nil))
+	assert.Equal(t, "frame-ancestors https://example.com; script-src 'nonce-"+rec.Body.String()+"'", rec.Header().Get("Content-Security-Policy"))
+
+	// Without router middleware the route creates the nonce itself
+	rec = httptest.NewRecorder()
+	shm.HandleRoute(nonceHandler, route, settings).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/embed", nil))
+	assert.NotEmpty(t, rec.Body.String())
+	assert.Contains(t, rec.Header().Get("Content-Security-Policy"), "'nonce-"+rec.Body.String()+"'")
+
+	// Routes without settings keep the configured policy
+	handler := shm.HandleRoute(nonceHandler, route, nil)
+	rec = httptest.NewRecorder()
+	shm.Handle(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/embed", nil))
+	assert.Contains(t, rec.Header().Get("Content-Security-Policy"), "default-src 'self'")
+}
+
+func TestSecurityHeadersMiddlewareRouteFrameOptions(t *testing.T) {
+	shm := newTestSecurityHeadersMiddleware(false)
+	route := interfaces.Route{Path: "/embed", TemplateFile: "app/embed/page.templ"}
+
+	tests := []struct {
+		name         string
+		settings     *interfaces.SecuritySettings
+		frameOptions []string
+	}{
+		{"default", nil, []string{"DENY"}},
+		{"policy only keeps the default", &interfaces.SecuritySettings{ContentSecurityPolicy: "script-src 'self'"}, []string{"DENY"}},
+		{"same origin", &interfaces.SecuritySettings{FrameOptions: interfaces.FrameOptionsSameOrigin}, []string{"SAMEORIGIN"}},
+		{"none omits the header", &interfaces.SecuritySettings{FrameOptions: interfaces.FrameOptionsNone}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			shm.Handle(shm.HandleRoute(nonceHandler, route, tt.settings)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/embed", nil))
+			assert.Equal(t, tt.frameOptions, rec.Header().Values("X-Frame-Options"))
+		})
+	}
+
+	// Frame options alone keep the configured policy
+	rec := httptest.NewRecorder()
+	settings := &interfaces.SecuritySettings{FrameOptions: interfaces.FrameOptionsSameOrigin}
+	shm.Handle(shm.HandleRoute(nonceHandler, route, settings)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/embed", nil))
+	assert.Contains(t, rec.Header().Get("Content-Security-Policy"), "default-src 'self'")
+}
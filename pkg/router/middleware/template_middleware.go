@@ -7,6 +7,7 @@ import (
 
 	"github.com/a-h/templ"
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/metadata"
 	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
@@ -17,7 +18,9 @@ type templateMiddleware struct {
 	templateService    interfaces.TemplateService
 	layoutService      interfaces.LayoutService
 	errorService       interfaces.ErrorService
+	configService      interfaces.ConfigService
 	parameterExtractor ParameterExtractor
+	renderMode         string
 	logger             *zap.Logger
 }
 
@@ -36,13 +39,16 @@ func NewTemplateMiddleware(i do.Injector) (TemplateMiddlewareInterface, error) {
 	layoutService := do.MustInvoke[interfaces.LayoutService](i)
 	errorService := do.MustInvoke[interfaces.ErrorService](i)
 	parameterExtractor := do.MustInvoke[ParameterExtractor](i)
+	configService := do.MustInvoke[interfaces.ConfigService](i)
 	logger := do.MustInvoke[*zap.Logger](i)
 
 	return &templateMiddleware{
 		templateService:    templateService,
 		layoutService:      layoutService,
 		errorService:       errorService,
+		configService:      configService,
 		parameterExtractor: parameterExtractor,
+		renderMode:         configService.GetRouterRenderMode(),
 		logger:             logger,
 	}, nil
 }
@@ -58,6 +64,11 @@ func (tm *templateMiddleware) Handle(route interfaces.Route, params map[string]s
 		// Load template config and add to context for router.M() access
 		ctx = tm.addTemplateConfigToContext(ctx, route.TemplateFile)
 
+		// Layouts flush at their boundaries when streaming
+		if tm.renderMode != "" {
+			ctx = context.WithValue(ctx, shared.RenderModeKey, tm.renderMode)
+		}
+
 		tm.logger.Debug("Rendering template",
 			zap.String("template", route.TemplateFile),
 			zap.String("path", route.Path),
@@ -66,52 +77,135 @@ func (tm *templateMiddleware) Handle(route interfaces.Route, params map[string]s
 			zap.Bool("requires_data_service", route.RequiresDataService),
 			zap.String("data_service_interface", route.DataServiceInterface))
 
-		// Render the page component (TemplateService now handles DataService templates directly)
+		// Responses differ for HTMX requests, so shared caches must keep them apart
+		w.Header().Add("Vary", shared.HXRequestHeader)
+		w.Header().Add("Vary", shared.HXTargetHeader)
+
+		// HTMX swaps partial responses into the current page, so they are rendered without layout
+		partial := shared.IsHTMXPartialRequest(r)
+		status := http.StatusOK
+
+		// A form submission renders the page again with the status of its action result
+		if result, ok := ctx.Value(shared.ActionResultKey).(*interfaces.ActionResult); ok && result.Status != 0 {
+			status = result.Status
+		}
+
 		var component templ.Component
 		var err error
 
-		component, err = tm.templateService.RenderComponent(route, routerCtx, ctx)
-		if err != nil {
-			tm.logger.Error("Template rendering failed",
-				zap.String("route", route.Path),
-				zap.String("template", route.TemplateFile),
-				zap.Error(err))
+		// Render a named fragment of the page if one was requested
+		fragment, explicit := tm.requestedFragment(r, partial)
+		if fragment != "" {
+			component, err = tm.templateService.RenderFragment(route, fragment, routerCtx, ctx)
+			if err != nil {
+				if explicit {
+					tm.logger.Warn("Requested fragment not found",
+						zap.String("route", route.Path),
+						zap.String("fragment", fragment),
+						zap.Error(err))
 
-			// Render error component
-			component = tm.errorService.CreateErrorComponent(err.Error(), route.Path)
+					status = http.StatusNotFound
+					component = tm.errorComponent(r, route, status, err)
+				} else {
+					// HX-Target usually names an element id, not a component
+					component = nil
+				}
+			}
 		}
 
-		// Wrap in layout if available
-		if layout := tm.layoutService.FindLayoutForTemplate(route.TemplateFile); layout != nil {
-			tm.logger.Debug("Wrapping component in layout",
-				zap.String("layout", layout.FilePath),
-				zap.Int("layout_level", layout.LayoutLevel))
+		if component == nil {
+			// Render the page component (TemplateService now handles DataService templates directly)
+			component, err = tm.templateService.RenderComponent(route, routerCtx, ctx)
+			if err != nil {
+				tm.logger.Error("Template rendering failed",
+					zap.String("route", route.Path),
+					zap.String("template", route.TemplateFile),
+					zap.Error(err))
+
+				// Render error component
+				status = http.StatusInternalServerError
+				component = tm.errorComponent(r, route, status, err)
+			}
+
+			// Wrap in layout if available (metadata may select another layout or none)
+			if !partial {
+				if layout := tm.resolveLayout(ctx, route); layout != nil {
+					tm.logger.Debug("Wrapping component in layout",
+						zap.String("layout", layout.FilePath),
+						zap.Int("layout_level", layout.LayoutLevel),
+						zap.Int("layout_chain", len(layout.Chain())))
 
-			component = tm.layoutService.WrapInLayout(component, layout, ctx)
+					component = tm.layoutService.WrapInLayout(component, layout, ctx)
+				}
+			}
 		}
 
 		// Render the final component
 		if component != nil {
-			w.Header().Set("Content-Type", "text/html")
-			if err := component.Render(ctx, w); err != nil {
-				tm.logger.Error("Component rendering failed",
-					zap.String("route", route.Path),
-					zap.Error(err))
-				http.Error(w, "Template rendering error", http.StatusInternalServerError)
-			}
+			tm.renderResponse(w, r.WithContext(ctx), route, component, status)
 		} else {
-			tm.renderFallback(w, route)
+			tm.renderFallback(w, r.WithContext(ctx), route)
 		}
 	})
 }
 
+// errorComponent returns the error template for status nearest to the route's template.
+// In development, server errors show the diagnostics overlay on top of it.
+func (tm *templateMiddleware) errorComponent(r *http.Request, route interfaces.Route, status int, cause error) templ.Component {
+	info := NewRequestErrorInfo(r, status, cause)
+	component := tm.errorService.CreateErrorInfoComponent(info, ErrorLookupPath(tm.configService, route.TemplateFile))
+
+	if status >= http.StatusInternalServerError && tm.configService.IsDevelopment() {
+		return withDevErrorOverlay(component, tm.devDiagnostics(r, route, status, cause))
+	}
+	return component
+}
+
+// devDiagnostics collects the development error diagnostics of the route
+func (tm *templateMiddleware) devDiagnostics(r *http.Request, route interfaces.Route, status int, cause error) *devErrorDiagnostics {
+	return newDevErrorDiagnostics(tm.configService, r, route, status, cause, tm.resolveLayout(r.Context(), route))
+}
+
+// writeDevErrorPage writes the diagnostics page in development and reports whether it did
+func (tm *templateMiddleware) writeDevErrorPage(w http.ResponseWriter, r *http.Request, route interfaces.Route, status int, cause error) bool {
+	if !tm.configService.IsDevelopment() {
+		return false
+	}
+
+	if err := devErrorPage(tm.devDiagnostics(r, route, status, cause)).Render(r.Context(), w); err != nil {
+		tm.logger.Error("Development error page rendering failed",
+			zap.String("route", route.Path),
+			zap.Error(err))
+	}
+	return true
+}
+
+// requestedFragment returns the fragment named by the ?fragment= query parameter (explicit)
+// or, for HTMX partial requests, by the HX-Target header
+func (tm *templateMiddleware) requestedFragment(r *http.Request, partial bool) (string, bool) {
+	if fragment := r.URL.Query().Get(shared.FragmentQueryParam); fragment != "" {
+		return fragment, true
+	}
+
+	if partial {
+		return r.Header.Get(shared.HXTargetHeader), false
+	}
+
+	return "", false
+}
+
 // renderFallback renders a fallback response when template is not found
-func (tm *templateMiddleware) renderFallback(w http.ResponseWriter, route interfaces.Route) {
+func (tm *templateMiddleware) renderFallback(w http.ResponseWriter, r *http.Request, route interfaces.Route) {
 	tm.logger.Warn("Rendering fallback for missing template",
 		zap.String("template", route.TemplateFile),
 		zap.String("path", route.Path))
 
 	w.Header().Set("Content-Type", "text/html")
+	if tm.writeDevErrorPage(w, r, route, http.StatusInternalServerError,
+		shared.NewTemplateError("template not found").WithContext("template", route.TemplateFile)) {
+		return
+	}
+
 	response := "<html><head><title>Template Not Found</title></head><body>"
 	response += "<h1>Template not found: " + route.TemplateFile + "</h1>"
 	response += "<p>Route: " + route.Path + "</p>"
@@ -120,6 +214,40 @@ func (tm *templateMiddleware) renderFallback(w http.ResponseWriter, route interf
 	w.Write([]byte(response))
 }
 
+// resolveLayout honours the "layout" metadata of the page before falling back to the nearest layout
+func (tm *templateMiddleware) resolveLayout(ctx context.Context, route interfaces.Route) *interfaces.LayoutTemplate {
+	templateConfig, ok := ctx.Value(shared.TemplateConfigKey).(*shared.ConfigFile)
+	if !ok || templateConfig.LayoutSettings == nil {
+		return tm.layoutService.FindLayoutForTemplate(route.TemplateFile)
+	}
+
+	settings := metadata.NewMetadataSettingsParser().ParseLayoutSettings(templateConfig.LayoutSettings)
+	if settings.None {
+		tm.logger.Debug("Rendering without layout",
+			zap.String("template", route.TemplateFile))
+		return nil
+	}
+
+	var layout *interfaces.LayoutTemplate
+	if settings.Name != "" {
+		layout = tm.layoutService.FindNamedLayout(settings.Name)
+		if layout == nil {
+			tm.logger.Warn("Layout selected in metadata not found, using nearest layout",
+				zap.String("template", route.TemplateFile),
+				zap.String("layout", settings.Name))
+		}
+	}
+	if layout == nil {
+		layout = tm.layoutService.FindLayoutForTemplate(route.TemplateFile)
+	}
+
+	// "inherit: false" on a page keeps only its closest layout
+	if layout != nil && !settings.Inherit {
+		layout.Parent = nil
+	}
+	return layout
+}
+
 // addTemplateConfigToContext loads template config and adds it to context for router.M() access
 func (tm *templateMiddleware) addTemplateConfigToContext(ctx context.Context, templateFile string) context.Context {
 	// Build YAML metadata path from template file
@@ -0,0 +1,325 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fragmentTestTemplateService renders the page and its "stats" fragment as plain text
+type fragmentTestTemplateService struct {
+	layoutTestTemplateService
+}
+
+func (m *fragmentTestTemplateService) RenderComponent(route interfaces.Route, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error) {
+	return templ.Raw("page"), nil
+}
+
+func (m *fragmentTestTemplateService) RenderFragment(route interfaces.Route, fragment string, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error) {
+	if fragment == "stats" {
+		return templ.Raw("stats"), nil
+	}
+	return nil, shared.NewTemplateError("fragment not found").WithContext("fragment", fragment)
+}
+
+// fragmentTestErrorService renders errors as plain text
+type fragmentTestErrorService struct{}
+
+func (m *fragmentTestErrorService) FindErrorTemplateForPath(path string) *interfaces.ErrorTemplate {
+	return nil
+}
+
+func (m *fragmentTestErrorService) CreateErrorComponent(message, path string) templ.Component {
+	return templ.Raw("error")
+}
+
+func (m *fragmentTestErrorService) CreateErrorInfoComponent(info *interfaces.ErrorInfo, lookupPath string) templ.Component {
+	return templ.Raw("error")
+}
+
+func TestTemplateMiddlewareHandlePartialAndFragmentRequests(t *testing.T) {
+	rootDir := setupLayoutTree(t, "")
+	templateService := &fragmentTestTemplateService{}
+	configService := &layoutTestConfigService{rootDir: rootDir}
+	tm := &templateMiddleware{
+		templateService: templateService,
+		layoutService:   newTestLayoutService(configService, templateService),
+		errorService:    &fragmentTestErrorService{},
+		configService:   configService,
+		logger:          zap.NewNop(),
+	}
+
+	route := interfaces.Route{
+		Path:         "/{locale}/dashboard",
+		TemplateFile: filepath.Join(rootDir, "locale_", "dashboard", "page.templ"),
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		headers        map[string]string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "Full page", url: "/en/dashboard", expectedStatus: http.StatusOK, expectedBody: "<app><dashboard>page</dashboard></app>"},
+		{name: "HTMX partial", url: "/en/dashboard", headers: map[string]string{"HX-Request": "true"}, expectedStatus: http.StatusOK, expectedBody: "page"},
+		{name: "HTMX boosted", url: "/en/dashboard", headers: map[string]string{"HX-Request": "true", "HX-Boosted": "true"}, expectedStatus: http.StatusOK, expectedBody: "<app><dashboard>page</dashboard></app>"},
+		{name: "HX-Target fragment", url: "/en/dashboard", headers: map[string]string{"HX-Request": "true", "HX-Target": "stats"}, expectedStatus: http.StatusOK, expectedBody: "stats"},
+		{name: "Unknown HX-Target falls back to page", url: "/en/dashboard", headers: map[string]string{"HX-Request": "true", "HX-Target": "main"}, expectedStatus: http.StatusOK, expectedBody: "page"},
+		{name: "Fragment query", url: "/en/dashboard?fragment=stats", expectedStatus: http.StatusOK, expectedBody: "stats"},
+		{name: "Unknown fragment query", url: "/en/dashboard?fragment=missing", expectedStatus: http.StatusNotFound, expectedBody: "error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+
+			tm.Handle(route, nil).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedBody, rec.Body.String())
+			assert.Contains(t, rec.Header().Values("Vary"), "HX-Request")
+		})
+	}
+}
+
+// failingTestTemplateService renders a page that fails after writing some output
+type failingTestTemplateService struct {
+	layoutTestTemplateService
+}
+
+func (m *failingTestTemplateService) RenderComponent(route interfaces.Route, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error) {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, _ = io.WriteString(w, "partial")
+		return errors.New("render failed")
+	}), nil
+}
+
+func TestTemplateMiddlewareRenderModes(t *testing.T) {
+	rootDir := setupLayoutTree(t, "")
+	route := interfaces.Route{
+		Path:         "/{locale}/dashboard",
+		TemplateFile: filepath.Join(rootDir, "locale_", "dashboard", "page.templ"),
+	}
+
+	newMiddleware := func(templateService interfaces.TemplateService, renderMode string) *templateMiddleware {
+		// Production keeps the development diagnostics out of the compared bodies
+		configService := &errorTestConfigService{
+			layoutTestConfigService: layoutTestConfigService{rootDir: rootDir},
+			production:              true,
+		}
+		return &templateMiddleware{
+			templateService: templateService,
+			layoutService:   newTestLayoutService(configService, templateService),
+			errorService:    &fragmentTestErrorService{},
+			configService:   configService,
+			renderMode:      renderMode,
+			logger:          zap.NewNop(),
+		}
+	}
+
+	t.Run("Buffered success", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newMiddleware(&fragmentTestTemplateService{}, interfaces.RenderModeBuffered).Handle(route, nil).
+			ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/en/dashboard", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "<app><dashboard>page</dashboard></app>", rec.Body.String())
+		assert.False(t, rec.Flushed)
+	})
+
+	t.Run("Buffered failure renders the error page", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newMiddleware(&failingTestTemplateService{}, interfaces.RenderModeBuffered).Handle(route, nil).
+			ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/en/dashboard", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, "error", rec.Body.String())
+	})
+
+	t.Run("Streaming flushes at layout boundaries", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newMiddleware(&fragmentTestTemplateService{}, interfaces.RenderModeStreaming).Handle(route, nil).
+			ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/en/dashboard", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "<app><dashboard>page</dashboard></app>", rec.Body.String())
+		assert.True(t, rec.Flushed)
+	})
+
+	t.Run("Direct rendering does not flush", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newMiddleware(&fragmentTestTemplateService{}, interfaces.RenderModeDirect).Handle(route, nil).
+			ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/en/dashboard", nil))
+
+		assert.Equal(t, "<app><dashboard>page</dashboard></app>", rec.Body.String())
+		assert.False(t, rec.Flushed)
+	})
+}
+
+// errorTemplateTestService renders the resolved error template's file path
+type errorTemplateTestService struct{}
+
+func (m *errorTemplateTestService) IsErrorTemplateAvailable(errorTemplate *interfaces.ErrorTemplate) bool {
+	return true
+}
+
+func (m *errorTemplateTestService) RenderErrorTemplate(errorTemplate *interfaces.ErrorTemplate, errorContext *ErrorContext) (templ.Component, error) {
+	return templ.Raw("error template: " + errorTemplate.FilePath), nil
+}
+
+// newBufferedFailureTestMiddleware renders a nested page that fails in buffered mode
+func newBufferedFailureTestMiddleware(t *testing.T, production bool) (*templateMiddleware, interfaces.Route, string) {
+	rootDir := setupLayoutTree(t, "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "error.templ"), "")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "locale_", "dashboard", "error.templ"), "")
+
+	logger := zap.NewNop()
+	configService := &errorTestConfigService{
+		layoutTestConfigService: layoutTestConfigService{rootDir: rootDir},
+		production:              production,
+	}
+	templateService := &failingTestTemplateService{}
+	tm := &templateMiddleware{
+		templateService: templateService,
+		layoutService:   newTestLayoutService(configService, templateService),
+		errorService: &ErrorServiceCore{
+			templateResolver: &errorTemplateResolverImpl{
+				configService:     configService,
+				fileSystemChecker: &ProductiveFileSystemChecker{logger: logger},
+				logger:            logger,
+			},
+			renderer:              NewErrorRenderer(),
+			dedicatedErrorService: &errorTemplateTestService{},
+			logger:                logger,
+		},
+		configService: configService,
+		renderMode:    interfaces.RenderModeBuffered,
+		logger:        logger,
+	}
+
+	route := interfaces.Route{
+		Path:         "/{locale}/dashboard",
+		TemplateFile: filepath.Join(rootDir, "locale_", "dashboard", "page.templ"),
+	}
+	return tm, route, rootDir
+}
+
+func TestTemplateMiddlewareBufferedFailureUsesNearestErrorTemplate(t *testing.T) {
+	tm, route, rootDir := newBufferedFailureTestMiddleware(t, true)
+
+	rec := httptest.NewRecorder()
+	tm.Handle(route, nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/en/dashboard", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "error template: "+filepath.Join(rootDir, "locale_", "dashboard", "error.templ"), rec.Body.String())
+}
+
+func TestTemplateMiddlewareDevelopmentErrorOverlay(t *testing.T) {
+	tm, route, rootDir := newBufferedFailureTestMiddleware(t, false)
+
+	rec := httptest.NewRecorder()
+	tm.Handle(route, nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/en/dashboard", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	body := rec.Body.String()
+	assert.True(t, strings.HasPrefix(body, "error template: "+filepath.Join(rootDir, "locale_", "dashboard", "error.templ")),
+		"the overlay is rendered on top of the error template")
+	assert.Contains(t, body, "templ-router-dev-error")
+	assert.Contains(t, body, "/{locale}/dashboard")
+	assert.Contains(t, body, "render failed")
+	assert.Contains(t, body, filepath.Join(rootDir, "locale_", "dashboard", "layout.templ"))
+	assert.Contains(t, body, "title: Root")
+}
+
+func TestTemplateMiddlewareFallbackShowsDiagnosticsOnlyInDevelopment(t *testing.T) {
+	for _, production := range []bool{false, true} {
+		tm, route, _ := newBufferedFailureTestMiddleware(t, production)
+
+		rec := httptest.NewRecorder()
+		tm.renderFallback(rec, httptest.NewRequest(http.MethodGet, "/en/dashboard", nil), route)
+
+		if production {
+			assert.Contains(t, rec.Body.String(), "Template not found")
+			assert.NotContains(t, rec.Body.String(), "templ-router-dev-error")
+		} else {
+			assert.Contains(t, rec.Body.String(), "templ-router-dev-error")
+			assert.Contains(t, rec.Body.String(), "TEMPLATE_ERROR")
+		}
+	}
+}
+
+// devErrorTestConfigService names page metadata files with a custom extension
+type devErrorTestConfigService struct {
+	layoutTestConfigService
+}
+
+func (m *devErrorTestConfigService) GetMetadataExtension() string { return ".meta.yaml" }
+
+func TestDevErrorDiagnosticsUsesMetadataExtension(t *testing.T) {
+	rootDir := t.TempDir()
+	writeLayoutTestFile(t, filepath.Join(rootDir, "page.templ.yaml"), "metadata:\n  title: Ignored\n")
+	writeLayoutTestFile(t, filepath.Join(rootDir, "page.meta.yaml"), "metadata:\n  title: Page\n")
+
+	config := &devErrorTestConfigService{layoutTestConfigService{rootDir: rootDir}}
+	route := interfaces.Route{Path: "/page", TemplateFile: filepath.Join(rootDir, "page.templ")}
+
+	diagnostics := newDevErrorDiagnostics(config, httptest.NewRequest(http.MethodGet, "/page", nil), route,
+		http.StatusInternalServerError, errors.New("render failed"), nil)
+
+	assert.Contains(t, diagnostics.Config, "title: Page")
+	assert.NotContains(t, diagnostics.Config, "Ignored")
+}
+
+func TestDevErrorOverlayIsInjectedBeforeClosingBody(t *testing.T) {
+	diagnostics := &devErrorDiagnostics{StatusCode: http.StatusInternalServerError, Message: "render failed"}
+	render := func(html string) string {
+		page := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, html)
+			return err
+		})
+		var out strings.Builder
+		require.NoError(t, withDevErrorOverlay(page, diagnostics).Render(context.Background(), &out))
+		return out.String()
+	}
+
+	body := render("<html><body><h1>Error</h1></BODY></html>")
+	overlay := strings.Index(body, "templ-router-dev-error")
+	require.Greater(t, overlay, strings.Index(body, "<h1>Error</h1>"))
+	assert.Less(t, overlay, strings.Index(body, "</BODY>"))
+	assert.True(t, strings.HasSuffix(body, "</BODY></html>"))
+
+	body = render("<h1>Error</h1>")
+	assert.True(t, strings.HasPrefix(body, "<h1>Error</h1>"), "fragments get the overlay appended")
+	assert.Contains(t, body, "templ-router-dev-error")
+}
+
+func TestDevErrorChain(t *testing.T) {
+	cause := shared.NewTemplateError("fragment not found").
+		WithContext("fragment", "stats").
+		WithCause(errors.New("registry miss"))
+
+	chain := devErrorChain(fmt.Errorf("render: %w", cause))
+	require.Len(t, chain, 3)
+	assert.Equal(t, "render: "+cause.Error(), chain[0].Message)
+	assert.Equal(t, "fragment not found", chain[1].Message)
+	assert.Equal(t, map[string]string{"fragment": "stats"}, chain[1].Context)
+	assert.Equal(t, "registry miss", chain[2].Message)
+}
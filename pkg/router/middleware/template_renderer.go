@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/a-h/templ"
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"go.uber.org/zap"
+)
+
+// maxPooledBufferSize keeps buffers of unusually large pages out of the pool
+const maxPooledBufferSize = 1 << 20
+
+// renderBufferPool provides buffers for the buffered render mode
+var renderBufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+func getRenderBuffer() *bytes.Buffer {
+	buf := renderBufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+func putRenderBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	renderBufferPool.Put(buf)
+}
+
+// renderResponse writes the component with the configured render mode
+func (tm *templateMiddleware) renderResponse(w http.ResponseWriter, r *http.Request, route interfaces.Route, component templ.Component, status int) {
+	switch tm.renderMode {
+	case interfaces.RenderModeBuffered:
+		tm.renderBuffered(w, r, route, component, status)
+	case interfaces.RenderModeStreaming:
+		tm.renderStreaming(w, r, route, component, status)
+	default:
+		tm.renderDirect(w, r, route, component, status)
+	}
+}
+
+// renderDirect renders straight into the response. A failure halfway leaves a partial page.
+func (tm *templateMiddleware) renderDirect(w http.ResponseWriter, r *http.Request, route interfaces.Route, component templ.Component, status int) {
+	w.Header().Set("Content-Type", "text/html")
+	if status != http.StatusOK {
+		w.WriteHeader(status)
+	}
+	if err := component.Render(r.Context(), w); err != nil {
+		tm.logger.Error("Component rendering failed",
+			zap.String("route", route.Path),
+			zap.Error(err))
+		// The status is already sent; in development the diagnostics cover the partial page
+		if tm.writeDevErrorPage(w, r, route, http.StatusInternalServerError, err) {
+			return
+		}
+		http.Error(w, "Template rendering error", http.StatusInternalServerError)
+	}
+}
+
+// renderBuffered renders into a pooled buffer and only sends it on success.
+// On failure nothing has been sent yet, so the error template is served with a 500.
+func (tm *templateMiddleware) renderBuffered(w http.ResponseWriter, r *http.Request, route interfaces.Route, component templ.Component, status int) {
+	buf := getRenderBuffer()
+	defer putRenderBuffer(buf)
+
+	ctx := r.Context()
+	if err := component.Render(ctx, buf); err != nil {
+		tm.logger.Error("Component rendering failed",
+			zap.String("route", route.Path),
+			zap.Error(err))
+
+		buf.Reset()
+		status = http.StatusInternalServerError
+		if renderErr := tm.errorComponent(r, route, status, err).Render(ctx, buf); renderErr != nil {
+			tm.logger.Error("Error component rendering failed",
+				zap.String("route", route.Path),
+				zap.Error(renderErr))
+
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(status)
+			if tm.writeDevErrorPage(w, r, route, status, err) {
+				return
+			}
+			http.Error(w, "Template rendering error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(status)
+	if _, err := buf.WriteTo(w); err != nil {
+		tm.logger.Debug("Writing buffered response failed",
+			zap.String("route", route.Path),
+			zap.Error(err))
+	}
+}
+
+// renderStreaming renders into the response; layouts flush before rendering their content,
+// so the browser can load the document head while the page is still rendering.
+// Failures after the first flush can no longer change the status code.
+func (tm *templateMiddleware) renderStreaming(w http.ResponseWriter, r *http.Request, route interfaces.Route, component templ.Component, status int) {
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(status)
+	if err := component.Render(r.Context(), w); err != nil {
+		tm.logger.Error("Streaming component rendering failed after the response was committed",
+			zap.String("route", route.Path),
+			zap.Error(err))
+	}
+}
+
+// isStreamingRender reports whether the request renders in streaming mode
+func isStreamingRender(ctx context.Context) bool {
+	mode, _ := ctx.Value(shared.RenderModeKey).(string)
+	return mode == interfaces.RenderModeStreaming
+}
+
+// flushingComponent flushes the output written so far before rendering its content
+type flushingComponent struct {
+	content templ.Component
+}
+
+func (fc *flushingComponent) Render(ctx context.Context, w io.Writer) error {
+	switch f := w.(type) {
+	case interface{ Flush() error }:
+		if err := f.Flush(); err != nil {
+			return err
+		}
+	case http.Flusher:
+		f.Flush()
+	}
+	return fc.content.Render(ctx, w)
+}
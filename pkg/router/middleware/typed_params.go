@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/google/uuid"
+)
+
+// convertTypedParam converts a raw URL parameter into the Go value for its declared type
+// int -> int, uuid -> uuid.UUID, date -> time.Time, enum and string -> string
+func convertTypedParam(paramType, format, value string) (interface{}, error) {
+	switch paramType {
+	case "", interfaces.ParameterTypeString, interfaces.ParameterTypeEnum:
+		return value, nil
+	case interfaces.ParameterTypeInt:
+		return strconv.Atoi(value)
+	case interfaces.ParameterTypeUUID:
+		return uuid.Parse(value)
+	case interfaces.ParameterTypeDate:
+		return time.Parse(dateParameterLayout(format), value)
+	default:
+		return nil, fmt.Errorf("unsupported parameter type: %s", paramType)
+	}
+}
+
+// dateParameterLayout returns the configured date layout or the default one
+func dateParameterLayout(format string) string {
+	if format == "" {
+		return interfaces.DefaultDateParameterFormat
+	}
+	return format
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
 	"github.com/denkhaus/templ-router/pkg/router/middleware"
+	"github.com/denkhaus/templ-router/pkg/shared"
 
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
@@ -12,11 +13,17 @@ import (
 
 // HandlerPipeline creates clean, composable HTTP handlers using middleware pattern
 type HandlerPipeline struct {
-	authMiddleware     middleware.AuthMiddlewareInterface
-	i18nMiddleware     middleware.I18nMiddlewareInterface
-	templateMiddleware middleware.TemplateMiddlewareInterface
-	templateRegistry   interfaces.TemplateRegistry
-	logger             *zap.Logger
+	authMiddleware                middleware.AuthMiddlewareInterface
+	i18nMiddleware                middleware.I18nMiddlewareInterface
+	parameterValidationMiddleware middleware.ParameterValidationMiddlewareInterface
+	templateMiddleware            middleware.TemplateMiddlewareInterface
+	contentNegotiationMiddleware  middleware.ContentNegotiationMiddlewareInterface
+	actionMiddleware              middleware.ActionMiddlewareInterface
+	recoveryMiddleware            middleware.RecoveryMiddlewareInterface
+	rateLimitMiddleware           middleware.RateLimitMiddlewareInterface
+	securityHeadersMiddleware     middleware.SecurityHeadersMiddlewareInterface
+	templateRegistry              interfaces.TemplateRegistry
+	logger                        *zap.Logger
 }
 
 // PipelineConfig contains configuration for building a handler pipeline
@@ -29,23 +36,39 @@ type PipelineConfig struct {
 
 // ConfigFile represents template configuration (simplified)
 type ConfigFile struct {
-	AuthSettings *interfaces.AuthSettings
+	AuthSettings      *interfaces.AuthSettings
+	DynamicSettings   *interfaces.DynamicSettings
+	APISettings       *interfaces.APISettings
+	RateLimitSettings *interfaces.RateLimitSettings
+	SecuritySettings  *interfaces.SecuritySettings
 	// Add other config fields as needed
 }
 
 func NewHandlerPipeline(i do.Injector) (*HandlerPipeline, error) {
 	authMiddleware := do.MustInvoke[middleware.AuthMiddlewareInterface](i)
 	i18nMiddleware := do.MustInvoke[middleware.I18nMiddlewareInterface](i)
+	parameterValidationMiddleware := do.MustInvoke[middleware.ParameterValidationMiddlewareInterface](i)
 	templateMiddleware := do.MustInvoke[middleware.TemplateMiddlewareInterface](i)
+	contentNegotiationMiddleware := do.MustInvoke[middleware.ContentNegotiationMiddlewareInterface](i)
+	actionMiddleware := do.MustInvoke[middleware.ActionMiddlewareInterface](i)
+	recoveryMiddleware := do.MustInvoke[middleware.RecoveryMiddlewareInterface](i)
+	rateLimitMiddleware := do.MustInvoke[middleware.RateLimitMiddlewareInterface](i)
+	securityHeadersMiddleware := do.MustInvoke[middleware.SecurityHeadersMiddlewareInterface](i)
 	templateRegistry := do.MustInvoke[interfaces.TemplateRegistry](i)
 	logger := do.MustInvoke[*zap.Logger](i)
 
 	return &HandlerPipeline{
-		authMiddleware:     authMiddleware,
-		i18nMiddleware:     i18nMiddleware,
-		templateMiddleware: templateMiddleware,
-		templateRegistry:   templateRegistry,
-		logger:             logger,
+		authMiddleware:                authMiddleware,
+		i18nMiddleware:                i18nMiddleware,
+		parameterValidationMiddleware: parameterValidationMiddleware,
+		templateMiddleware:            templateMiddleware,
+		contentNegotiationMiddleware:  contentNegotiationMiddleware,
+		actionMiddleware:              actionMiddleware,
+		recoveryMiddleware:            recoveryMiddleware,
+		rateLimitMiddleware:           rateLimitMiddleware,
+		securityHeadersMiddleware:     securityHeadersMiddleware,
+		templateRegistry:              templateRegistry,
+		logger:                        logger,
 	}, nil
 
 }
@@ -66,16 +89,66 @@ func (hp *HandlerPipeline) BuildHandler(config PipelineConfig) http.Handler {
 			zap.String("data_service_interface", config.Route.DataServiceInterface))
 	}
 
-	// All routes use template middleware (which now handles DataService templates internally)
-	handler = hp.templateMiddleware.Handle(config.Route, config.Params)
+	var dynamicSettings *interfaces.DynamicSettings
+	var apiSettings *interfaces.APISettings
+	var rateLimitSettings *interfaces.RateLimitSettings
+	var securitySettings *interfaces.SecuritySettings
+	if config.ConfigFile != nil {
+		dynamicSettings = config.ConfigFile.DynamicSettings
+		apiSettings = config.ConfigFile.APISettings
+		rateLimitSettings = config.ConfigFile.RateLimitSettings
+		securitySettings = config.ConfigFile.SecuritySettings
+	}
+
+	if config.Route.IsHandler {
+		// Route handlers of route.go files write the response themselves
+		handler = hp.routeHandler(config.Route)
+	} else {
+		// All pages use template middleware (which now handles DataService templates internally)
+		handler = hp.templateMiddleware.Handle(config.Route, config.Params)
+
+		// Serve the DataService result as JSON to requests asking for it instead of the page
+		handler = hp.contentNegotiationMiddleware.Handle(handler, config.Route, apiSettings)
+
+		// Run the page action for form submissions before the page is rendered again
+		handler = hp.actionMiddleware.Handle(handler, config.Route)
+	}
+
+	// Reject invalid dynamic parameters before the template and its DataService run
+	handler = hp.parameterValidationMiddleware.Handle(handler, config.Route, dynamicSettings)
 
 	// Wrap with i18n middleware
 	handler = hp.i18nMiddleware.Handle(handler, config.Route.TemplateFile)
 
-	// Wrap with auth middleware (outermost)
+	// Wrap with auth middleware
 	authSettings := hp.resolveAuthSettings(config)
 	handler = hp.authMiddleware.Handle(handler, authSettings)
 
+	// Remove a .json suffix from the trailing parameter before it is validated
+	handler = hp.contentNegotiationMiddleware.HandleSuffix(handler, config.Route, apiSettings)
+
+	// Reject clients over their rate limit before auth and data services run
+	handler = hp.rateLimitMiddleware.Handle(handler, config.Route, rateLimitSettings)
+
+	// Replace the Content-Security-Policy with the route's, also for its error pages
+	handler = hp.securityHeadersMiddleware.HandleRoute(handler, config.Route, securitySettings)
+
+	// Recover panics of the whole pipeline, including auth and data services
+	handler = hp.recoveryMiddleware.Handle(handler, config.Route)
+
+	return handler
+}
+
+// routeHandler returns the Handler of the route.go file of a route
+func (hp *HandlerPipeline) routeHandler(route interfaces.Route) http.Handler {
+	handler, exists := hp.templateRegistry.GetRouteHandler(shared.RouteHandlerKey(route.TemplateFile))
+	if !exists {
+		hp.logger.Error("Route handler missing from template registry",
+			zap.String("route", route.Path),
+			zap.String("file", route.TemplateFile))
+		return http.NotFoundHandler()
+	}
+
 	return handler
 }
 
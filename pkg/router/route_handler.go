@@ -0,0 +1,14 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/middleware"
+)
+
+// RouterContextFromRequest returns the RouterContext of a request, e.g. for the URL and query
+// parameters in the Handler of a route.go file
+func RouterContextFromRequest(r *http.Request) interfaces.RouterContext {
+	return middleware.NewRouterContext(r.Context(), r)
+}
@@ -0,0 +1,189 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/i18n"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"go.uber.org/zap"
+)
+
+// RegisterRedirects registers declarative redirects and rewrites.
+// Must be called before RegisterRoutes so page routes can still claim their own patterns.
+func (rr *routeRegistrar) RegisterRedirects(rules []interfaces.RedirectRule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	rr.logger.Info("Registering redirects", zap.Int("count", len(rules)))
+
+	for _, rule := range rules {
+		if err := rr.validateRedirectForRegistration(rule); err != nil {
+			return shared.NewRouteError("invalid redirect", err.Error()).
+				WithContext("from", rule.From).
+				WithContext("to", rule.To).
+				WithContext("source", rule.Source)
+		}
+
+		var handler http.Handler
+		if rule.Rewrite {
+			handler = rr.rewriteHandler(rule)
+		} else {
+			handler = rr.redirectHandler(rule)
+		}
+
+		patterns, err := rr.mountHandler("", rule.From, handler)
+		if err != nil {
+			return err
+		}
+
+		rr.logger.Debug("Redirect registered",
+			zap.String("from", rule.From),
+			zap.String("to", rule.To),
+			zap.Bool("rewrite", rule.Rewrite),
+			zap.Int("status", rule.Status),
+			zap.Strings("patterns", patterns))
+	}
+
+	return nil
+}
+
+// validateRedirectForRegistration performs basic validation before redirect registration
+func (rr *routeRegistrar) validateRedirectForRegistration(rule interfaces.RedirectRule) error {
+	if rule.From == "" || rule.To == "" {
+		return fmt.Errorf("redirect needs both from and to")
+	}
+
+	if !strings.HasPrefix(rule.From, "/") {
+		return fmt.Errorf("redirect source must start with '/'")
+	}
+
+	if shared.HasNonTrailingCatchAll(rule.From) {
+		return fmt.Errorf("catch-all segment must be the last segment of the redirect source")
+	}
+
+	if rule.Rewrite && !strings.HasPrefix(rule.To, "/") {
+		return fmt.Errorf("rewrite target must be an internal path")
+	}
+
+	if !rule.Rewrite && !interfaces.IsValidRedirectStatus(rule.Status) {
+		return fmt.Errorf("unsupported redirect status %d", rule.Status)
+	}
+
+	return nil
+}
+
+// redirectHandler answers with an HTTP redirect to the expanded target
+func (rr *routeRegistrar) redirectHandler(rule interfaces.RedirectRule) http.HandlerFunc {
+	status := rule.Status
+	if status == 0 {
+		status = interfaces.DefaultRedirectStatus
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		target := shared.JoinBasePath(shared.GetBasePath(r.Context()), rr.resolveRedirectTarget(r, rule.To))
+		if r.URL.RawQuery != "" && !strings.Contains(target, "?") {
+			target += "?" + r.URL.RawQuery
+		}
+
+		rr.logger.Debug("Redirecting request",
+			zap.String("path", r.URL.Path),
+			zap.String("target", target),
+			zap.Int("status", status))
+
+		http.Redirect(w, r, target, status)
+	}
+}
+
+// rewriteHandler serves the target route for the request without changing the URL.
+// Only one rewrite is followed per request, so rewrite chains cannot loop.
+func (rr *routeRegistrar) rewriteHandler(rule interfaces.RedirectRule) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if from, ok := r.Context().Value(shared.RewrittenFromKey).(string); ok {
+			rr.logger.Warn("Rewrite chain stopped",
+				zap.String("rewritten_from", from),
+				zap.String("path", r.URL.Path),
+				zap.String("to", rule.To))
+			http.Error(w, "Rewrite loop detected", http.StatusLoopDetected)
+			return
+		}
+
+		target := rr.resolveRedirectTarget(r, rule.To)
+		path, query, hasQuery := shared.SplitPatternQuery(target)
+
+		rewritten := r.WithContext(context.WithValue(r.Context(), shared.RewrittenFromKey, r.URL.Path))
+		if hasQuery {
+			rewritten = rewritten.Clone(rewritten.Context())
+			rewritten.URL.RawQuery = query
+		}
+
+		rr.logger.Debug("Rewriting request",
+			zap.String("path", r.URL.Path),
+			zap.String("target", target))
+
+		// Dispatch through the router again; the URL keeps the mount prefix
+		rr.router.Redispatch(w, rewritten, path)
+	}
+}
+
+// resolveRedirectTarget fills the parameters captured from the source pattern into the
+// target and localizes a remaining {locale} placeholder with the request locale
+func (rr *routeRegistrar) resolveRedirectTarget(r *http.Request, target string) string {
+	target = expandRedirectTarget(target, func(name string) string {
+		return rr.router.URLParam(r, name)
+	})
+
+	if !strings.Contains(target, "{locale}") {
+		return target
+	}
+
+	ctx := r.Context()
+	if rr.middlewareSetup != nil {
+		if i18nService := rr.middlewareSetup.GetI18nService(); i18nService != nil {
+			if locale := i18nService.ExtractLocale(r); locale != "" {
+				ctx = context.WithValue(ctx, shared.LocaleKey, locale)
+			}
+		}
+	}
+
+	return i18n.LocalizeRouteIfRequired(ctx, target)
+}
+
+// expandRedirectTarget replaces parameter segments of a target pattern with captured values.
+// Empty catch-all values drop their segment, e.g. /docs/{slug...?} -> /docs.
+// {locale} is left untouched when it was not captured, so it can be localized later.
+func expandRedirectTarget(target string, param func(name string) string) string {
+	path, query, hasQuery := shared.SplitPatternQuery(target)
+
+	segments := strings.Split(path, "/")
+	expanded := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		if name, _, ok := shared.ParseCatchAllSegment(segment); ok {
+			if value := param(name); value != "" {
+				expanded = append(expanded, value)
+			}
+			continue
+		}
+
+		if name, ok := shared.ParseRouteParamSegment(segment); ok {
+			if value := param(name); value != "" {
+				segment = value
+			}
+		}
+		expanded = append(expanded, segment)
+	}
+
+	path = strings.Join(expanded, "/")
+	if path == "" {
+		path = "/"
+	}
+
+	if hasQuery {
+		return path + "?" + query
+	}
+	return path
+}
@@ -0,0 +1,151 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/adapter"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestRouteRegistrarRegisterRedirects(t *testing.T) {
+	tests := []struct {
+		name             string
+		rule             interfaces.RedirectRule
+		requestPath      string
+		expectedCode     int
+		expectedLocation string
+	}{
+		{
+			name:             "Default status is permanent",
+			rule:             interfaces.RedirectRule{From: "/old", To: "/new"},
+			requestPath:      "/old",
+			expectedCode:     http.StatusMovedPermanently,
+			expectedLocation: "/new",
+		},
+		{
+			name:             "Parameters are copied to the target",
+			rule:             interfaces.RedirectRule{From: "/users/{id}", To: "/{locale}/user/{id}", Status: http.StatusFound},
+			requestPath:      "/users/42",
+			expectedCode:     http.StatusFound,
+			expectedLocation: "/en/user/42",
+		},
+		{
+			name:             "Captured locale wins over request locale",
+			rule:             interfaces.RedirectRule{From: "/{locale}/blog/{slug...}", To: "/{locale}/news/{slug...}", Status: http.StatusPermanentRedirect},
+			requestPath:      "/de/blog/2024/launch",
+			expectedCode:     http.StatusPermanentRedirect,
+			expectedLocation: "/de/news/2024/launch",
+		},
+		{
+			name:             "Empty optional catch-all drops its segment",
+			rule:             interfaces.RedirectRule{From: "/guide/{path...?}", To: "/docs/{path...?}", Status: http.StatusTemporaryRedirect},
+			requestPath:      "/guide",
+			expectedCode:     http.StatusTemporaryRedirect,
+			expectedLocation: "/docs",
+		},
+		{
+			name:             "Query string is preserved",
+			rule:             interfaces.RedirectRule{From: "/search", To: "/find"},
+			requestPath:      "/search?q=templ",
+			expectedCode:     http.StatusMovedPermanently,
+			expectedLocation: "/find?q=templ",
+		},
+	}
+
+	for adapterName, newAdapter := range routerAdapterFactories {
+		for _, tt := range tests {
+			t.Run(adapterName+"/"+tt.name, func(t *testing.T) {
+				rr := &routeRegistrar{router: newAdapter(), logger: zap.NewNop()}
+				assert.NoError(t, rr.RegisterRedirects([]interfaces.RedirectRule{tt.rule}))
+
+				rec := httptest.NewRecorder()
+				rr.router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.requestPath, nil))
+
+				assert.Equal(t, tt.expectedCode, rec.Code)
+				assert.Equal(t, tt.expectedLocation, rec.Header().Get("Location"))
+			})
+		}
+	}
+}
+
+func TestRouteRegistrarRegisterRewrites(t *testing.T) {
+	for adapterName, newAdapter := range routerAdapterFactories {
+		t.Run(adapterName, func(t *testing.T) {
+			rr := &routeRegistrar{router: newAdapter(), logger: zap.NewNop()}
+
+			_, err := rr.mountRoute("/{locale}/user/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				params := adapter.FromRequest(r).URLParams(r)
+				_, _ = w.Write([]byte(params["locale"] + ":" + params["id"] + ":" + r.URL.RawQuery))
+			}))
+			assert.NoError(t, err)
+
+			err = rr.RegisterRedirects([]interfaces.RedirectRule{
+				{From: "/me/{id}", To: "/en/user/{id}", Rewrite: true},
+				{From: "/a", To: "/b", Rewrite: true},
+				{From: "/b", To: "/a", Rewrite: true},
+			})
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			rr.router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/me/7?tab=posts", nil))
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "en:7:tab=posts", rec.Body.String())
+			assert.Empty(t, rec.Header().Get("Location"))
+
+			rec = httptest.NewRecorder()
+			rr.router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/a", nil))
+			assert.Equal(t, http.StatusLoopDetected, rec.Code)
+		})
+	}
+}
+
+func TestRouteRegistrarRejectsInvalidRedirects(t *testing.T) {
+	rules := []interfaces.RedirectRule{
+		{From: "/old"},
+		{From: "old", To: "/new"},
+		{From: "/old", To: "/new", Status: http.StatusOK},
+		{From: "/old", To: "https://example.com", Rewrite: true},
+		{From: "/{path...}/edit", To: "/new"},
+	}
+
+	for _, rule := range rules {
+		rr := &routeRegistrar{router: adapter.NewChiAdapter(chi.NewRouter()), logger: zap.NewNop()}
+		assert.Error(t, rr.RegisterRedirects([]interfaces.RedirectRule{rule}), "rule %+v", rule)
+	}
+}
+
+func TestRouteRegistrarRedirectsWithBasePath(t *testing.T) {
+	for adapterName, newAdapter := range routerAdapterFactories {
+		t.Run(adapterName, func(t *testing.T) {
+			root := newAdapter()
+			mounted, err := root.Mount("/portal")
+			assert.NoError(t, err)
+			rr := &routeRegistrar{router: mounted, logger: zap.NewNop()}
+
+			_, err = rr.mountRoute("/user/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(r.URL.Path + ":" + adapter.FromRequest(r).URLParam(r, "id")))
+			}))
+			assert.NoError(t, err)
+			err = rr.RegisterRedirects([]interfaces.RedirectRule{
+				{From: "/old/{id}", To: "/user/{id}"},
+				{From: "/me/{id}", To: "/user/{id}", Rewrite: true},
+			})
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			root.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/portal/old/7", nil))
+			assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+			assert.Equal(t, "/portal/user/7", rec.Header().Get("Location"))
+
+			rec = httptest.NewRecorder()
+			root.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/portal/me/7", nil))
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "/portal/user/7:7", rec.Body.String())
+		})
+	}
+}
@@ -1,12 +1,16 @@
 package router
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
-	"github.com/go-chi/chi/v5"
+	"github.com/denkhaus/templ-router/pkg/router/middleware"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -14,14 +18,15 @@ import (
 // RouteRegistrar defines the contract for route registration
 type RouteRegistrar interface {
 	RegisterRoutes(routes []interfaces.Route) error
-	RegisterStaticRoutes()
-	Register404Handler()
-	RegisterMethodNotAllowedHandler()
+	RegisterRedirects(rules []interfaces.RedirectRule) error
+	RegisterStaticRoutes() error
+	Register404Handler() error
+	RegisterMethodNotAllowedHandler() error
 }
 
 // routeRegistrar handles route registration logic (private implementation)
 type routeRegistrar struct {
-	router          *chi.Mux
+	router          interfaces.RouterAdapter
 	handlerBuilder  HandlerBuilder
 	middlewareSetup MiddlewareSetup
 	configService   interfaces.ConfigService
@@ -30,7 +35,7 @@ type routeRegistrar struct {
 }
 
 // NewRouteRegistrar creates a new route registrar
-func NewRouteRegistrar(i do.Injector, router *chi.Mux) (RouteRegistrar, error) {
+func NewRouteRegistrar(i do.Injector, router interfaces.RouterAdapter) (RouteRegistrar, error) {
 	handlerBuilder, err := NewHandlerBuilder(i)
 	if err != nil {
 		return nil, err
@@ -59,6 +64,12 @@ func NewRouteRegistrar(i do.Injector, router *chi.Mux) (RouteRegistrar, error) {
 func (rr *routeRegistrar) RegisterRoutes(routes []interfaces.Route) error {
 	rr.logger.Info("Registering routes", zap.Int("count", len(routes)))
 
+	// Most specific routes first, then fail fast before anything reaches Chi
+	routes = sortRoutesByPrecedence(routes)
+	if err := rr.detectAmbiguousRoutes(routes); err != nil {
+		return err
+	}
+
 	for _, route := range routes {
 		if err := rr.registerSingleRoute(route); err != nil {
 			rr.logger.Error("Failed to register route",
@@ -71,6 +82,44 @@ func (rr *routeRegistrar) RegisterRoutes(routes []interfaces.Route) error {
 	return nil
 }
 
+// sortRoutesByPrecedence returns a copy of routes ordered by descending precedence,
+// using the path as tie-breaker so registration order is deterministic
+func sortRoutesByPrecedence(routes []interfaces.Route) []interfaces.Route {
+	sorted := make([]interfaces.Route, len(routes))
+	copy(sorted, routes)
+
+	sort.SliceStable(sorted, func(a, b int) bool {
+		if sorted[a].Precedence != sorted[b].Precedence {
+			return sorted[a].Precedence > sorted[b].Precedence
+		}
+		return sorted[a].Path < sorted[b].Path
+	})
+
+	return sorted
+}
+
+// detectAmbiguousRoutes rejects route pairs that match the same requests with equal precedence.
+// Chi would either panic or silently let one of them win, so startup fails instead.
+func (rr *routeRegistrar) detectAmbiguousRoutes(routes []interfaces.Route) error {
+	for i := 0; i < len(routes); i++ {
+		for j := i + 1; j < len(routes) && routes[j].Precedence == routes[i].Precedence; j++ {
+			if !shared.RoutePatternsOverlap(routes[i].Path, routes[j].Path) {
+				continue
+			}
+
+			return shared.NewRouteError("ambiguous routes",
+				fmt.Sprintf("%s and %s match the same requests with equal precedence", routes[i].Path, routes[j].Path)).
+				WithContext("route", routes[i].Path).
+				WithContext("template", routes[i].TemplateFile).
+				WithContext("conflicting_route", routes[j].Path).
+				WithContext("conflicting_template", routes[j].TemplateFile).
+				WithContext("precedence", routes[i].Precedence)
+		}
+	}
+
+	return nil
+}
+
 // registerSingleRoute registers a single route with proper handler and middleware
 func (rr *routeRegistrar) registerSingleRoute(route interfaces.Route) error {
 	// Validate route before registration
@@ -83,99 +132,167 @@ func (rr *routeRegistrar) registerSingleRoute(route interfaces.Route) error {
 		return rr.registerLocaleSpecificRoutes(route)
 	}
 
-	// Convert route pattern for Chi router (replace $id with Chi syntax)
-	chiPattern := rr.convertRoutePattern(route.Path)
-
 	// Build handler with middleware pipeline
 	handler := rr.handlerBuilder.BuildHandler(route)
 
-	// Register with Chi router
-	rr.router.Get(chiPattern, handler.ServeHTTP)
+	// Register through the router adapter (converts $name and catch-all segments)
+	patterns, err := rr.mountRouteMethods(route, route.Path, handler)
+	if err != nil {
+		return err
+	}
 
 	rr.logger.Debug("Route registered",
 		zap.String("original_pattern", route.Path),
-		zap.String("chi_pattern", chiPattern),
+		zap.Strings("patterns", patterns),
 		zap.String("template", route.TemplateFile),
 		zap.Bool("dynamic", route.IsDynamic))
 
 	return nil
 }
 
-// convertRoutePattern converts router patterns to Chi router syntax
-func (rr *routeRegistrar) convertRoutePattern(pattern string) string {
-	// Convert $locale to {locale} for Chi
-	chiPattern := strings.ReplaceAll(pattern, "$locale", "{locale}")
+// mountRoute registers a GET handler for a route pattern and returns the patterns used
+func (rr *routeRegistrar) mountRoute(pattern string, handler http.Handler) ([]string, error) {
+	return rr.mountHandler(http.MethodGet, pattern, handler)
+}
 
-	// Convert $id to {id} for Chi
-	chiPattern = strings.ReplaceAll(chiPattern, "$id", "{id}")
+// mountRouteMethods registers the handler of a route for its request methods and returns the patterns
+// used. Pages answer GET and the form submissions of their Action, route handlers every method.
+func (rr *routeRegistrar) mountRouteMethods(route interfaces.Route, pattern string, handler http.Handler) ([]string, error) {
+	if route.IsHandler {
+		var patterns []string
+		for _, method := range shared.RouteHandlerMethods {
+			mounted, err := rr.mountHandler(method, pattern, handler)
+			if err != nil {
+				return nil, err
+			}
+			patterns = mounted
+		}
+		return patterns, nil
+	}
 
-	// Handle other dynamic parameters if needed
-	// Add more conversions as the router grows
+	patterns, err := rr.mountRoute(pattern, handler)
+	if err != nil {
+		return nil, err
+	}
+	if err := rr.mountActions(route, pattern, handler); err != nil {
+		return nil, err
+	}
 
-	return chiPattern
+	return patterns, nil
+}
+
+// mountActions registers the page handler for the form submission methods of a page with an Action
+func (rr *routeRegistrar) mountActions(route interfaces.Route, pattern string, handler http.Handler) error {
+	if !route.HasAction {
+		return nil
+	}
+
+	for _, method := range shared.ActionMethods {
+		if _, err := rr.mountHandler(method, pattern, handler); err != nil {
+			return err
+		}
+	}
+
+	rr.logger.Debug("Page action registered",
+		zap.String("pattern", pattern),
+		zap.Strings("methods", shared.ActionMethods),
+		zap.String("template", route.TemplateFile))
+
+	return nil
+}
+
+// mountHandler registers a handler for a route pattern (empty method for all methods) and
+// returns the patterns used. Optional catch-alls are additionally mounted on the section root.
+func (rr *routeRegistrar) mountHandler(method, pattern string, handler http.Handler) ([]string, error) {
+	if err := rr.router.Handle(method, pattern, handler); err != nil {
+		return nil, err
+	}
+	patterns := []string{pattern}
+
+	if base, _, optional, isCatchAll := shared.SplitCatchAllPattern(pattern); isCatchAll && optional {
+		if err := rr.router.Handle(method, base, handler); err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, base)
+	}
+
+	return patterns, nil
 }
 
 // RegisterStaticRoutes registers static file serving routes
-func (rr *routeRegistrar) RegisterStaticRoutes() {
-	rr.assetService.SetupRoutes(rr.router)
+func (rr *routeRegistrar) RegisterStaticRoutes() error {
+	if err := rr.assetService.SetupRoutes(rr.router); err != nil {
+		return err
+	}
 	rr.logger.Debug("Static routes registered")
+	return nil
 }
 
 // Register404Handler registers the 404 not found handler
-func (rr *routeRegistrar) Register404Handler() {
-	rr.router.NotFound(func(w http.ResponseWriter, r *http.Request) {
+func (rr *routeRegistrar) Register404Handler() error {
+	return rr.router.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		// /users.json is the JSON representation of /users; pages without JSON API answer 404 again
+		if path, ok := shared.TrimJSONSuffix(r.URL.Path); ok && !shared.IsJSONSuffixRequest(r) &&
+			(r.Method == http.MethodGet || r.Method == http.MethodHead) {
+			middleware.ServeJSONSuffix(w, r, path)
+			return
+		}
+
 		rr.logger.Info("404 handler triggered",
 			zap.String("path", r.URL.Path),
 			zap.String("method", r.Method))
 
-		// Use error service to render 404 page
-		errorService := rr.middlewareSetup.GetErrorService()
-		if errorService != nil {
-			component := errorService.CreateErrorComponent("The requested page could not be found.", r.URL.Path)
-			if component != nil {
-				w.Header().Set("Content-Type", "text/html")
-				w.WriteHeader(http.StatusNotFound)
-				if err := component.Render(r.Context(), w); err != nil {
-					rr.logger.Error("Failed to render 404 page", zap.Error(err))
-					http.Error(w, "Page not found", http.StatusNotFound)
-				}
-				return
-			}
-		}
-
-		// Fallback to simple 404
-		http.Error(w, "Page not found", http.StatusNotFound)
+		rr.renderStatusError(w, r, http.StatusNotFound, nil)
 	})
 }
 
 // RegisterMethodNotAllowedHandler registers the 405 method not allowed handler
-func (rr *routeRegistrar) RegisterMethodNotAllowedHandler() {
-	rr.router.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+func (rr *routeRegistrar) RegisterMethodNotAllowedHandler() error {
+	return rr.router.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
 		rr.logger.Info("405 handler triggered",
 			zap.String("path", r.URL.Path),
 			zap.String("method", r.Method))
 
-		errorService := rr.middlewareSetup.GetErrorService()
-		if errorService != nil {
-			component := errorService.CreateErrorComponent(
-				fmt.Sprintf("Method %s not allowed for this path.", r.Method),
-				r.URL.Path)
-			if component != nil {
-				w.Header().Set("Content-Type", "text/html")
-				w.WriteHeader(http.StatusMethodNotAllowed)
-				if err := component.Render(r.Context(), w); err != nil {
-					rr.logger.Error("Failed to render 405 page", zap.Error(err))
-					http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-				}
-				return
-			}
-		}
-
-		// Fallback to simple 405
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		rr.renderStatusError(w, r, http.StatusMethodNotAllowed,
+			fmt.Errorf("method %s not allowed for this path", r.Method))
 	})
 }
 
+// renderStatusError renders the nearest error template for status (e.g. not-found.templ).
+// These requests did not pass the route middleware, so locale and translations are set up here.
+func (rr *routeRegistrar) renderStatusError(w http.ResponseWriter, r *http.Request, status int, cause error) {
+	errorService := rr.middlewareSetup.GetErrorService()
+	if errorService == nil {
+		// Fallback to simple error response
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	info := middleware.NewRequestErrorInfo(r, status, cause)
+	component := errorService.CreateErrorInfoComponent(info, r.URL.Path)
+	if component == nil {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	ctx := r.Context()
+	if i18nService := rr.middlewareSetup.GetI18nService(); i18nService != nil && rr.configService != nil {
+		if locale := i18nService.ExtractLocale(r); locale != "" {
+			ctx = context.WithValue(ctx, shared.LocaleKey, locale)
+		}
+		rootLayout := filepath.Join(rr.configService.GetLayoutRootDirectory(),
+			rr.configService.GetLayoutFileName()+rr.configService.GetTemplateExtension())
+		ctx = i18nService.CreateContext(ctx, rootLayout)
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(status)
+	if err := component.Render(ctx, w); err != nil {
+		rr.logger.Error("Failed to render error page",
+			zap.Int("status", status),
+			zap.Error(err))
+	}
+}
 
 // registerLocaleSpecificRoutes registers specific routes for each valid locale
 func (rr *routeRegistrar) registerLocaleSpecificRoutes(route interfaces.Route) error {
@@ -204,21 +321,23 @@ func (rr *routeRegistrar) registerLocaleSpecificRoutes(route interfaces.Route) e
 			RequiresDataService:  route.RequiresDataService,
 			DataServiceInterface: route.DataServiceInterface,
 			DataParameterType:    route.DataParameterType,
+			HasAction:            route.HasAction,
+			IsHandler:            route.IsHandler,
 		}
 
-		// Convert pattern for Chi router
-		chiPattern := rr.convertRoutePattern(localeRoute.Path)
-
 		// Build handler with middleware pipeline
 		handler := rr.handlerBuilder.BuildHandler(localeRoute)
 
-		// Register with Chi router
-		rr.router.Get(chiPattern, handler.ServeHTTP)
+		// Register through the router adapter
+		patterns, err := rr.mountRouteMethods(localeRoute, localeRoute.Path, handler)
+		if err != nil {
+			return err
+		}
 
 		rr.logger.Debug("Locale-specific route registered",
 			zap.String("locale", locale),
 			zap.String("original_pattern", route.Path),
-			zap.String("chi_pattern", chiPattern),
+			zap.Strings("patterns", patterns),
 			zap.String("template", route.TemplateFile))
 	}
 
@@ -247,6 +366,11 @@ func (rr *routeRegistrar) validateRouteForRegistration(route interfaces.Route) e
 		return fmt.Errorf("route path contains double slashes")
 	}
 
+	// Catch-all segments must terminate the route
+	if shared.HasNonTrailingCatchAll(route.Path) {
+		return fmt.Errorf("catch-all segment must be the last segment of the route path")
+	}
+
 	// Check for conflicting dynamic parameters
 	if strings.Contains(route.Path, "$locale") && strings.Contains(route.Path, "{locale}") {
 		return fmt.Errorf("route path contains both $locale and {locale} syntax")
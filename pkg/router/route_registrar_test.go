@@ -0,0 +1,266 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/adapter"
+	"github.com/denkhaus/templ-router/pkg/router/middleware"
+	"github.com/denkhaus/templ-router/pkg/shared"
+	"github.com/go-chi/chi/v5"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// routerAdapterFactories creates a fresh adapter for every supported router
+var routerAdapterFactories = map[string]func() interfaces.RouterAdapter{
+	"chi":      func() interfaces.RouterAdapter { return adapter.NewChiAdapter(chi.NewRouter()) },
+	"servemux": func() interfaces.RouterAdapter { return adapter.NewServeMuxAdapter(http.NewServeMux()) },
+}
+
+func TestRouteRegistrarMountRouteCatchAll(t *testing.T) {
+	tests := []struct {
+		name          string
+		pattern       string
+		requestPath   string
+		expectedCode  int
+		expectedParam []string
+		// ServeMux redirects a subtree root without trailing slash instead of answering 404
+		serveMuxRedirects bool
+	}{
+		{
+			name:          "Catch-all matches nested path",
+			pattern:       "/docs/{slug...}",
+			requestPath:   "/docs/a/b/c",
+			expectedCode:  http.StatusOK,
+			expectedParam: []string{"a", "b", "c"},
+		},
+		{
+			name:              "Catch-all does not match section root",
+			pattern:           "/docs/{slug...}",
+			requestPath:       "/docs",
+			expectedCode:      http.StatusNotFound,
+			serveMuxRedirects: true,
+		},
+		{
+			name:          "Optional catch-all matches section root",
+			pattern:       "/docs/{slug...?}",
+			requestPath:   "/docs",
+			expectedCode:  http.StatusOK,
+			expectedParam: []string{},
+		},
+		{
+			name:          "Catch-all combined with named parameters",
+			pattern:       "/$locale/docs/$slug...",
+			requestPath:   "/en/docs/guide/intro",
+			expectedCode:  http.StatusOK,
+			expectedParam: []string{"guide", "intro"},
+		},
+	}
+
+	for adapterName, newAdapter := range routerAdapterFactories {
+		for _, tt := range tests {
+			t.Run(adapterName+"/"+tt.name, func(t *testing.T) {
+				router := newAdapter()
+				rr := &routeRegistrar{router: router, logger: zap.NewNop()}
+
+				var segments []string
+				handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					routerCtx := middleware.NewRouterContext(r.Context(), r)
+					segments = routerCtx.GetURLParamSegments("slug")
+					assert.Equal(t, strings.Join(segments, "/"), routerCtx.GetURLParam("slug"))
+					w.WriteHeader(http.StatusOK)
+				})
+
+				_, err := rr.mountRoute(tt.pattern, handler)
+				assert.NoError(t, err)
+
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.requestPath, nil))
+
+				if adapterName == "servemux" && tt.serveMuxRedirects {
+					assert.Equal(t, tt.requestPath+"/", rec.Header().Get("Location"))
+					return
+				}
+				assert.Equal(t, tt.expectedCode, rec.Code)
+				if tt.expectedCode == http.StatusOK {
+					assert.Equal(t, tt.expectedParam, segments)
+				}
+			})
+		}
+	}
+}
+
+func TestRouteRegistrarRejectsNonTrailingCatchAll(t *testing.T) {
+	rr := &routeRegistrar{router: adapter.NewChiAdapter(chi.NewRouter()), logger: zap.NewNop()}
+
+	err := rr.validateRouteForRegistration(interfaces.Route{
+		Path:         "/docs/{slug...}/edit",
+		TemplateFile: "app/docs/slug___/edit/page.templ",
+	})
+
+	assert.Error(t, err)
+}
+
+func TestSortRoutesByPrecedence(t *testing.T) {
+	routes := []interfaces.Route{
+		{Path: "/docs/{slug...}", Precedence: 55},
+		{Path: "/user/{id}", Precedence: 95},
+		{Path: "/user/settings", Precedence: 110},
+		{Path: "/about", Precedence: 105},
+		{Path: "/blog", Precedence: 105},
+	}
+
+	sorted := sortRoutesByPrecedence(routes)
+
+	var paths []string
+	for _, route := range sorted {
+		paths = append(paths, route.Path)
+	}
+	assert.Equal(t, []string{"/user/settings", "/about", "/blog", "/user/{id}", "/docs/{slug...}"}, paths)
+	assert.Equal(t, "/docs/{slug...}", routes[0].Path, "input slice must not be reordered")
+}
+
+func TestRouteRegistrarDetectAmbiguousRoutes(t *testing.T) {
+	rr := &routeRegistrar{router: adapter.NewChiAdapter(chi.NewRouter()), logger: zap.NewNop()}
+
+	t.Run("more specific route wins over dynamic sibling", func(t *testing.T) {
+		err := rr.detectAmbiguousRoutes(sortRoutesByPrecedence([]interfaces.Route{
+			{Path: "/user/{id}", Precedence: 95},
+			{Path: "/user/settings", Precedence: 110},
+		}))
+		assert.NoError(t, err)
+	})
+
+	t.Run("equal precedence overlap fails with route error", func(t *testing.T) {
+		err := rr.RegisterRoutes([]interfaces.Route{
+			{Path: "/user/{id}", TemplateFile: "app/user/id_/page.templ", Precedence: 95},
+			{Path: "/user/{name}", TemplateFile: "app/user/name_/page.templ", Precedence: 95},
+		})
+
+		var appErr *shared.AppError
+		if assert.ErrorAs(t, err, &appErr) {
+			assert.Equal(t, shared.ErrorTypeRoute, appErr.Type)
+			assert.Equal(t, "/user/{id}", appErr.Context["route"])
+			assert.Equal(t, "/user/{name}", appErr.Context["conflicting_route"])
+		}
+	})
+}
+
+func TestRouteRegistrarMountActions(t *testing.T) {
+	for adapterName, newAdapter := range routerAdapterFactories {
+		t.Run(adapterName, func(t *testing.T) {
+			router := newAdapter()
+			rr := &routeRegistrar{router: router, logger: zap.NewNop()}
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(r.Method))
+			})
+
+			withAction := interfaces.Route{Path: "/contact", TemplateFile: "app/contact/page.templ", HasAction: true}
+			withoutAction := interfaces.Route{Path: "/about", TemplateFile: "app/about/page.templ"}
+			for _, route := range []interfaces.Route{withAction, withoutAction} {
+				_, err := rr.mountRoute(route.Path, handler)
+				assert.NoError(t, err)
+				assert.NoError(t, rr.mountActions(route, route.Path, handler))
+			}
+
+			for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete} {
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, httptest.NewRequest(method, "/contact", nil))
+				assert.Equal(t, http.StatusOK, rec.Code, method)
+				assert.Equal(t, method, rec.Body.String())
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/about", nil))
+			assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		})
+	}
+}
+
+func TestRouteRegistrarMountRouteHandlers(t *testing.T) {
+	for adapterName, newAdapter := range routerAdapterFactories {
+		t.Run(adapterName, func(t *testing.T) {
+			router := newAdapter()
+			rr := &routeRegistrar{router: router, logger: zap.NewNop()}
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(r.Method))
+			})
+
+			export := interfaces.Route{Path: "/reports/export", TemplateFile: "app/reports/export/route.go", IsHandler: true}
+			report := interfaces.Route{Path: "/reports/{id}", TemplateFile: "app/reports/id_/page.templ"}
+			for _, route := range []interfaces.Route{export, report} {
+				_, err := rr.mountRouteMethods(route, route.Path, handler)
+				assert.NoError(t, err)
+			}
+
+			for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodDelete} {
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, httptest.NewRequest(method, "/reports/export", nil))
+				assert.Equal(t, http.StatusOK, rec.Code, method)
+				assert.Equal(t, method, rec.Body.String())
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/reports/42", nil))
+			assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		})
+	}
+}
+
+// csrfExemptConfigService enables CSRF protection with exempt webhook paths
+type csrfExemptConfigService struct {
+	mockRouterConfigService
+}
+
+func (m *csrfExemptConfigService) IsCSRFEnabled() bool          { return true }
+func (m *csrfExemptConfigService) GetCSRFExemptPaths() []string { return []string{"/webhooks"} }
+
+func TestRouteRegistrarRouteHandlerCSRFExemption(t *testing.T) {
+	injector := do.New()
+	defer injector.Shutdown()
+
+	do.ProvideValue[interfaces.ConfigService](injector, &csrfExemptConfigService{})
+	do.ProvideValue[interfaces.ErrorService](injector, &mockErrorService{})
+	do.ProvideValue[interfaces.I18nService](injector, &mockI18nService{})
+	do.ProvideValue(injector, zap.NewNop())
+	do.Provide(injector, middleware.NewCSRFMiddleware)
+
+	routerMiddleware, err := middleware.NewRouterMiddleware(injector)
+	require.NoError(t, err)
+
+	router := adapter.NewChiAdapter(chi.NewRouter())
+	rr := &routeRegistrar{router: router, logger: zap.NewNop()}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Method))
+	})
+
+	webhook := interfaces.Route{Path: "/webhooks/payments", TemplateFile: "app/webhooks/payments/route.go", IsHandler: true}
+	export := interfaces.Route{Path: "/reports/export", TemplateFile: "app/reports/export/route.go", IsHandler: true}
+	for _, route := range []interfaces.Route{webhook, export} {
+		_, err := rr.mountRouteMethods(route, route.Path, handler)
+		require.NoError(t, err)
+	}
+
+	protected, err := routerMiddleware.ConfigureRouterMiddleware(router)
+	require.NoError(t, err)
+
+	// Webhooks post without a CSRF token
+	rec := httptest.NewRecorder()
+	protected.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhooks/payments", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, http.MethodPost, rec.Body.String())
+
+	// Other route handlers are still protected
+	rec = httptest.NewRecorder()
+	protected.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/reports/export", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -8,13 +8,19 @@ import (
 // RouterCore defines the contract for the clean router core
 type RouterCore interface {
 	Initialize() error
-	RegisterRoutes(chiRouter *chi.Mux) error
+	RegisterRoutes(chiRouter chi.Router) error
+	// RegisterRoutesWithAdapter registers the routes through a router adapter (chi or net/http ServeMux)
+	RegisterRoutesWithAdapter(router interfaces.RouterAdapter) error
 	GetRoutes() []interfaces.Route
 	GetLayoutTemplates() []LayoutTemplate
 	GetErrorTemplates() []ErrorTemplate
 	GetMiddlewareSetup() MiddlewareSetup
 	GetHandlerBuilder() HandlerBuilder
 	GetRouteRegistrar() RouteRegistrar
+	// GetRedirects returns the redirect and rewrite rules collected during initialization
+	GetRedirects() []interfaces.RedirectRule
+	// GetValidationResult returns the structured startup validation result (nil when validation is off)
+	GetValidationResult() *interfaces.ValidationResult
 }
 
 // RouteDiscovery interface for discovering routes, layouts, and error templates
@@ -29,4 +35,6 @@ type ConfigLoader interface {
 	LoadRouteConfig(templateFile string) (*interfaces.ConfigFile, error)
 	LoadConfig(templatePath string) (*interfaces.ConfigFile, error)
 	LoadAuthSettings(templatePath string) (*interfaces.AuthSettings, error)
+	// LoadRedirects loads the global redirects.yaml from the template root directory
+	LoadRedirects(rootDir string) ([]interfaces.RedirectRule, error)
 }
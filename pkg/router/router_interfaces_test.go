@@ -24,7 +24,7 @@ func (t *testRouterCore) Initialize() error {
 	return nil
 }
 
-func (t *testRouterCore) RegisterRoutes(chiRouter *chi.Mux) error {
+func (t *testRouterCore) RegisterRoutes(chiRouter chi.Router) error {
 	return nil
 }
 
@@ -52,6 +52,18 @@ func (t *testRouterCore) GetRouteRegistrar() RouteRegistrar {
 	return t.routeRegistrar
 }
 
+func (t *testRouterCore) RegisterRoutesWithAdapter(router interfaces.RouterAdapter) error {
+	return nil
+}
+
+func (t *testRouterCore) GetRedirects() []interfaces.RedirectRule {
+	return nil
+}
+
+func (t *testRouterCore) GetValidationResult() *interfaces.ValidationResult {
+	return nil
+}
+
 type testRouteDiscovery struct {
 	routes         []interfaces.Route
 	layouts        []LayoutTemplate
@@ -87,6 +99,10 @@ func (t *testConfigLoader) LoadAuthSettings(templatePath string) (*interfaces.Au
 	return t.authSettings, nil
 }
 
+func (t *testConfigLoader) LoadRedirects(rootDir string) ([]interfaces.RedirectRule, error) {
+	return nil, nil
+}
+
 func TestRouterCore_InterfaceCompliance(t *testing.T) {
 	// Verify that our test implementation satisfies the RouterCore interface
 	var _ RouterCore = (*testRouterCore)(nil)
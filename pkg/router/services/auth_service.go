@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -63,15 +64,21 @@ func (cas *CleanAuthService) Authenticate(req *http.Request, requirements *inter
 	return &interfaces.AuthResult{
 		IsAuthenticated: true,
 		User:            user,
+		Session:         session,
 	}, nil
 }
 
-// HasRequiredPermissions implements interfaces.AuthService
+// HasRequiredPermissions implements interfaces.AuthService. The user authenticated by the auth
+// middleware is read from the request context, so the session is not looked up (and renewed) twice.
 func (cas *CleanAuthService) HasRequiredPermissions(req *http.Request, settings *interfaces.AuthSettings) bool {
 	if settings == nil || settings.Type == interfaces.AuthTypePublic {
 		return true
 	}
 
+	if user, ok := req.Context().Value(shared.UserContextKey).(interfaces.UserEntity); ok {
+		return cas.userHasRequiredRoles(user, settings)
+	}
+
 	session, err := cas.sessionStore.GetSession(req)
 	if err != nil || !session.Valid {
 		return false
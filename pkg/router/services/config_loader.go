@@ -3,15 +3,20 @@ package services
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
 	"github.com/denkhaus/templ-router/pkg/router"
+	"github.com/denkhaus/templ-router/pkg/router/metadata"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
 )
 
+// redirectsFileName is the global redirects file below the template root directory
+const redirectsFileName = "redirects.yaml"
+
 // configLoaderImpl implements clean configuration loading
 type configLoaderImpl struct {
 	logger *zap.Logger
@@ -59,7 +64,10 @@ func (cl *configLoaderImpl) LoadConfig(templatePath string) (*interfaces.ConfigF
 	}
 
 	// Convert to interfaces.ConfigFile
-	config := &interfaces.ConfigFile{}
+	config := &interfaces.ConfigFile{
+		FilePath:         yamlPath,
+		TemplateFilePath: templatePath,
+	}
 
 	// Parse auth settings if present
 	if authData, ok := rawConfig["auth"]; ok {
@@ -73,9 +81,44 @@ func (cl *configLoaderImpl) LoadConfig(templatePath string) (*interfaces.ConfigF
 		}
 	}
 
+	// Parse dynamic parameter settings if present
+	if dynamicData, ok := rawConfig["dynamic"]; ok {
+		config.DynamicSettings = metadata.NewMetadataSettingsParser().ParseDynamicSettings(dynamicData)
+	}
+
+	// Parse JSON API settings if present
+	if apiData, ok := rawConfig["api"]; ok {
+		config.APISettings = metadata.NewMetadataSettingsParser().ParseAPISettings(apiData)
+	}
+
+	// Parse rate limit settings if present
+	if rateLimitData, ok := rawConfig["rate_limit"]; ok {
+		config.RateLimitSettings = metadata.NewMetadataSettingsParser().ParseRateLimitSettings(rateLimitData)
+		if config.RateLimitSettings == nil {
+			cl.logger.Warn("Ignoring invalid rate limit settings, expected requests >= 1, a duration like 1m as per and key ip, session or route",
+				zap.String("yaml_path", yamlPath))
+		}
+	}
+
+	// Parse security header settings if present
+	if securityData, ok := rawConfig["security"]; ok {
+		config.SecuritySettings = metadata.NewMetadataSettingsParser().ParseSecuritySettings(securityData)
+	}
+
+	// Keep layout settings ("layout: none", "layout: <name>") for validation and rendering
+	config.LayoutSettings = rawConfig["layout"]
+
+	// Parse redirects and rewrites if present
+	config.Redirects = cl.parseRedirectSections(rawConfig, yamlPath)
+
 	cl.logger.Debug("Config loaded successfully",
 		zap.String("template", templatePath),
-		zap.Bool("has_auth", config.AuthSettings != nil))
+		zap.Bool("has_auth", config.AuthSettings != nil),
+		zap.Bool("has_dynamic", config.DynamicSettings != nil),
+		zap.Bool("has_api", config.APISettings != nil),
+		zap.Bool("has_rate_limit", config.RateLimitSettings != nil),
+		zap.Bool("has_security", config.SecuritySettings != nil),
+		zap.Int("redirects", len(config.Redirects)))
 
 	return config, nil
 }
@@ -94,9 +137,50 @@ func (cl *configLoaderImpl) LoadAuthSettings(templatePath string) (*interfaces.A
 	return config.AuthSettings, nil
 }
 
-// getYAMLPath returns the YAML file path for a template
+// LoadRedirects implements router.ConfigLoader
+// Reads the global redirects.yaml from the template root directory
+func (cl *configLoaderImpl) LoadRedirects(rootDir string) ([]interfaces.RedirectRule, error) {
+	yamlPath := filepath.Join(rootDir, redirectsFileName)
+
+	data, err := os.ReadFile(yamlPath)
+	if os.IsNotExist(err) {
+		return nil, nil // No redirects file is not an error
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read redirects file %s: %w", yamlPath, err)
+	}
+
+	var rawConfig map[string]interface{}
+	if err := yaml.Unmarshal(data, &rawConfig); err != nil {
+		return nil, fmt.Errorf("failed to parse redirects file %s: %w", yamlPath, err)
+	}
+
+	rules := cl.parseRedirectSections(rawConfig, yamlPath)
+
+	cl.logger.Debug("Redirects loaded",
+		zap.String("yaml_path", yamlPath),
+		zap.Int("rules", len(rules)))
+
+	return rules, nil
+}
+
+// parseRedirectSections parses the "redirects" and "rewrites" sections of a YAML document
+func (cl *configLoaderImpl) parseRedirectSections(rawConfig map[string]interface{}, source string) []interfaces.RedirectRule {
+	parser := metadata.NewMetadataSettingsParser()
+
+	var rules []interfaces.RedirectRule
+	if redirectData, ok := rawConfig["redirects"]; ok {
+		rules = append(rules, parser.ParseRedirects(redirectData, false, source)...)
+	}
+	if rewriteData, ok := rawConfig["rewrites"]; ok {
+		rules = append(rules, parser.ParseRedirects(rewriteData, true, source)...)
+	}
+	return rules
+}
+
+// getYAMLPath returns the YAML file path for a template or route handler (route.go -> route.go.yaml)
 func (cl *configLoaderImpl) getYAMLPath(templatePath string) string {
-	if strings.HasSuffix(templatePath, ".templ") {
+	if strings.HasSuffix(templatePath, ".templ") || strings.HasSuffix(templatePath, ".go") {
 		return templatePath + ".yaml"
 	}
 	return templatePath + ".templ.yaml"
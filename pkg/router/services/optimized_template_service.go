@@ -20,6 +20,9 @@ import (
 type OptimizedTemplateService struct {
 	logger *zap.Logger
 
+	// Config service for the layout root directory
+	config interfaces.ConfigService
+
 	// Template registry interface for decoupled access
 	templateRegistry interfaces.TemplateRegistry
 
@@ -36,6 +39,7 @@ type OptimizedTemplateService struct {
 // NewOptimizedTemplateService creates the unified template service
 func NewOptimizedTemplateService(i do.Injector) (interfaces.TemplateService, error) {
 	logger := do.MustInvoke[*zap.Logger](i)
+	config := do.MustInvoke[interfaces.ConfigService](i)
 	templateRegistry := do.MustInvoke[interfaces.TemplateRegistry](i)
 	dataResolver := do.MustInvoke[interfaces.DataServiceResolver](i)
 	cacheService := do.MustInvoke[interfaces.CacheService](i)
@@ -48,6 +52,7 @@ func NewOptimizedTemplateService(i do.Injector) (interfaces.TemplateService, err
 
 	return &OptimizedTemplateService{
 		logger:           logger,
+		config:           config,
 		templateRegistry: templateRegistry,
 		routeConverter:   routeConverter,
 		dataResolver:     dataResolver,
@@ -101,6 +106,49 @@ func (ots *OptimizedTemplateService) RenderComponent(route interfaces.Route, rou
 	return component, nil
 }
 
+// RenderFragment implements interfaces.TemplateService for parameterless components
+// declared in the page package, e.g. fragment "Stats" of app/dashboard/page.templ resolves the
+// Stats component of app/dashboard. Pages, layouts and error templates are never fragments.
+func (ots *OptimizedTemplateService) RenderFragment(route interfaces.Route, fragment string, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error) {
+	componentKey := shared.PackageComponentKey(route.TemplateFile, fragment)
+	if componentKey == "" {
+		return nil, shared.NewTemplateError("invalid fragment name").
+			WithContext("route", route.Path).
+			WithContext("fragment", fragment)
+	}
+
+	// Only the components of the page package are looked up; the route mapping would also
+	// resolve child pages and layouts, which must go through their own routes
+	templateKey, exists := ots.templateRegistry.GetComponentTemplateKey(componentKey)
+	if !exists {
+		return nil, shared.NewTemplateError("fragment not found").
+			WithContext("route", route.Path).
+			WithContext("fragment", fragment)
+	}
+
+	templateFunc, found := ots.templateRegistry.GetTemplateFunction(templateKey)
+	if !found {
+		return nil, shared.NewTemplateError("fragment not found").
+			WithContext("route", route.Path).
+			WithContext("fragment", fragment)
+	}
+
+	// Only parameterless components can be rendered as fragments
+	fn, ok := templateFunc().(func() templ.Component)
+	if !ok {
+		return nil, shared.NewTemplateError("fragment is not a parameterless component").
+			WithContext("route", route.Path).
+			WithContext("fragment", fragment)
+	}
+
+	ots.logger.Debug("Fragment resolved",
+		zap.String("route", route.Path),
+		zap.String("component_key", componentKey),
+		zap.String("template_key", templateKey))
+
+	return fn(), nil
+}
+
 // RenderLayoutComponent implements interfaces.TemplateService with layout optimization
 func (ots *OptimizedTemplateService) RenderLayoutComponent(layoutPath string, content templ.Component, ctx context.Context) (templ.Component, error) {
 	ots.logger.Debug("Optimized layout rendering",
@@ -221,17 +269,37 @@ func (ots *OptimizedTemplateService) executeTemplateFunction(templateFunc func()
 
 	// Handle parameterized templates (e.g., user/product pages)
 	if fn, ok := result.(func(string) templ.Component); ok {
-		id := routerCtx.GetURLParam("id")
-		if id == "" {
-			return nil, shared.NewValidationError("parameter 'id' is required for parameterized template").
-				WithContext("route", routePath).
-				WithContext("template_uuid", templateUUID)
+		args, err := ots.resolveStringParams(routePath, templateUUID, 1, routerCtx)
+		if err != nil {
+			return nil, err
 		}
-		component := fn(id)
+		component := fn(args[0])
 		ots.logger.Debug("Parameterized template executed",
 			zap.String("route", routePath),
 			zap.String("template_uuid", templateUUID),
-			zap.String("id", id))
+			zap.Strings("params", args))
+		return component, nil
+	}
+
+	// Handle multi-parameter templates (e.g., func(orgId, projectId string) templ.Component)
+	if ots.isStringParamTemplate(result) {
+		fnValue := reflect.ValueOf(result)
+		args, err := ots.resolveStringParams(routePath, templateUUID, fnValue.Type().NumIn(), routerCtx)
+		if err != nil {
+			return nil, err
+		}
+		in := make([]reflect.Value, len(args))
+		for i, arg := range args {
+			in[i] = reflect.ValueOf(arg)
+		}
+		component, ok := fnValue.Call(in)[0].Interface().(templ.Component)
+		if !ok {
+			return nil, middleware.ErrTemplateNotFound
+		}
+		ots.logger.Debug("Multi-parameter template executed",
+			zap.String("route", routePath),
+			zap.String("template_uuid", templateUUID),
+			zap.Strings("params", args))
 		return component, nil
 	}
 
@@ -262,6 +330,67 @@ func (ots *OptimizedTemplateService) executeTemplateFunction(templateFunc func()
 	return nil, middleware.ErrTemplateNotFound
 }
 
+// isStringParamTemplate checks if the result is a template function taking only string parameters
+// Such templates have signature: func(string, string, ...) templ.Component
+func (ots *OptimizedTemplateService) isStringParamTemplate(result interface{}) bool {
+	resultType := reflect.TypeOf(result)
+	if resultType == nil || resultType.Kind() != reflect.Func {
+		return false
+	}
+	if resultType.NumIn() == 0 || resultType.NumOut() != 1 || resultType.IsVariadic() {
+		return false
+	}
+	for i := 0; i < resultType.NumIn(); i++ {
+		if resultType.In(i).Kind() != reflect.String {
+			return false
+		}
+	}
+	templComponentType := reflect.TypeOf((*templ.Component)(nil)).Elem()
+	return resultType.Out(0).Implements(templComponentType)
+}
+
+// resolveStringParams maps the dynamic segments of a route onto positional string parameters.
+// Parameters are taken in route-pattern order. The locale segment is skipped unless the
+// template explicitly accepts one parameter per dynamic segment.
+func (ots *OptimizedTemplateService) resolveStringParams(routePath, templateUUID string, count int, routerCtx interfaces.RouterContext) ([]string, error) {
+	names := shared.ExtractRouteParamNames(routePath)
+	if len(names) != count {
+		withoutLocale := make([]string, 0, len(names))
+		for _, name := range names {
+			if name != "locale" {
+				withoutLocale = append(withoutLocale, name)
+			}
+		}
+		names = withoutLocale
+	}
+
+	if len(names) != count {
+		// Name the first parameter that cannot be resolved
+		message := fmt.Sprintf("template parameter %d has no matching route parameter", len(names)+1)
+		if len(names) > count {
+			message = fmt.Sprintf("route parameter '%s' has no matching template parameter", names[count])
+		}
+		return nil, shared.NewValidationError(message).
+			WithContext("route", routePath).
+			WithContext("template_uuid", templateUUID).
+			WithContext("expected", count).
+			WithContext("route_params", names)
+	}
+
+	args := make([]string, count)
+	for i, name := range names {
+		value := routerCtx.GetURLParam(name)
+		if value == "" {
+			return nil, shared.NewValidationError(fmt.Sprintf("parameter '%s' is required for parameterized template", name)).
+				WithContext("route", routePath).
+				WithContext("template_uuid", templateUUID)
+		}
+		args[i] = value
+	}
+
+	return args, nil
+}
+
 // isDataServiceTemplate checks if the result is a DataService template function
 // DataService templates have signature: func(*SomeDataType) templ.Component
 func (ots *OptimizedTemplateService) isDataServiceTemplate(result interface{}) bool {
@@ -305,7 +434,8 @@ func (ots *OptimizedTemplateService) convertLayoutPathToRoute(layoutPath string)
 		return ""
 	}
 
-	// Library-agnostic conversion: any/path/layout.templ -> /layout
+	// Library-agnostic conversion like the template generator:
+	// app/layout.templ -> /layout, app/locale_/dashboard/layout.templ -> /{locale}/dashboard/layout
 	filename := filepath.Base(layoutPath)
 	if !strings.HasSuffix(filename, ".templ") {
 		err := shared.NewValidationError("invalid template file extension").
@@ -326,7 +456,32 @@ func (ots *OptimizedTemplateService) convertLayoutPathToRoute(layoutPath string)
 		return ""
 	}
 
-	return "/" + routeName
+	// Directories below the layout root directory become route segments
+	rootDir := filepath.Base(filepath.Clean(ots.config.GetLayoutRootDirectory()))
+	dirParts := strings.Split(filepath.ToSlash(filepath.Dir(layoutPath)), "/")
+	rootIndex := -1
+	for i := len(dirParts) - 1; i >= 0; i-- {
+		if dirParts[i] == rootDir {
+			rootIndex = i
+			break
+		}
+	}
+
+	var routeParts []string
+	if rootIndex >= 0 {
+		for _, part := range dirParts[rootIndex+1:] {
+			if paramName, optional, ok := shared.DirectoryToCatchAll(part); ok {
+				routeParts = append(routeParts, shared.CatchAllPatternSegment(paramName, optional))
+			} else if paramName, ok := shared.DirectoryToRouteParam(part); ok {
+				routeParts = append(routeParts, "{"+paramName+"}")
+			} else if part != "" {
+				routeParts = append(routeParts, part)
+			}
+		}
+	}
+
+	routeParts = append(routeParts, routeName)
+	return "/" + strings.Join(routeParts, "/")
 }
 
 // executeDataServiceTemplate handles DataService template execution with optimized method calls
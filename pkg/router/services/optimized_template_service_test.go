@@ -6,12 +6,14 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/a-h/templ"
 	"github.com/denkhaus/templ-router/pkg/interfaces"
 	"github.com/denkhaus/templ-router/pkg/router/middleware"
 	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
@@ -27,6 +29,28 @@ func (m *mockOTSTemplateRegistry) GetRouteToTemplateMapping() map[string]string
 	return args.Get(0).(map[string]string)
 }
 
+func (m *mockOTSTemplateRegistry) GetComponentTemplateKey(componentKey string) (string, bool) {
+	args := m.Called(componentKey)
+	return args.String(0), args.Bool(1)
+}
+
+func (m *mockOTSTemplateRegistry) GetAction(actionKey string) (interfaces.ActionFunc, bool) {
+	args := m.Called(actionKey)
+	action, _ := args.Get(0).(interfaces.ActionFunc)
+	return action, args.Bool(1)
+}
+
+func (m *mockOTSTemplateRegistry) GetRouteHandlerMapping() map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]string)
+}
+
+func (m *mockOTSTemplateRegistry) GetRouteHandler(handlerKey string) (http.Handler, bool) {
+	args := m.Called(handlerKey)
+	handler, _ := args.Get(0).(http.Handler)
+	return handler, args.Bool(1)
+}
+
 func (m *mockOTSTemplateRegistry) GetTemplateFunction(uuid string) (func() interface{}, bool) {
 	args := m.Called(uuid)
 	if args.Get(0) == nil {
@@ -179,6 +203,26 @@ func (m *mockOTSRouterContext) GetURLParam(key string) string {
 	return args.String(0)
 }
 
+func (m *mockOTSRouterContext) GetURLParamSegments(key string) []string {
+	args := m.Called(key)
+	return args.Get(0).([]string)
+}
+
+func (m *mockOTSRouterContext) GetURLParamInt(key string) (int, error) {
+	args := m.Called(key)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockOTSRouterContext) GetURLParamUUID(key string) (uuid.UUID, error) {
+	args := m.Called(key)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *mockOTSRouterContext) GetURLParamTime(key string) (time.Time, error) {
+	args := m.Called(key)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
 func (m *mockOTSRouterContext) GetAllURLParams() map[string]string {
 	args := m.Called()
 	return args.Get(0).(map[string]string)
@@ -226,6 +270,7 @@ func createTestOTS(t *testing.T) (*OptimizedTemplateService, *mockOTSTemplateReg
 
 	service := &OptimizedTemplateService{
 		logger:           logger,
+		config:           &mockConfigService{},
 		templateRegistry: mockRegistry,
 		cacheService:     mockCache,
 		dataResolver:     mockDataResolver,
@@ -328,7 +373,7 @@ func TestOptimizedTemplateService_RenderComponent_ParameterizedTemplate_MissingI
 	service, mockRegistry, mockCache, _, _ := createTestOTS(t)
 
 	route := interfaces.Route{
-		Path:         "/user/123",
+		Path:         "/user/{id}",
 		TemplateFile: "user.templ",
 	}
 	params := map[string]string{} // Missing ID parameter
@@ -350,11 +395,11 @@ func TestOptimizedTemplateService_RenderComponent_ParameterizedTemplate_MissingI
 
 	mockCache.On("BuildTemplateKey", "user.templ", "", params).Return(cacheKey)
 	mockCache.On("GetTemplate", cacheKey).Return(nil, false)
-	mockCache.On("BuildRouteKey", "/user/123", params).Return(routeCacheKey)
+	mockCache.On("BuildRouteKey", "/user/{id}", params).Return(routeCacheKey)
 	mockCache.On("GetRoute", routeCacheKey).Return(nil, false)
 
 	// Mock direct route mapping
-	routeMapping := map[string]string{"/user/123": templateUUID}
+	routeMapping := map[string]string{"/user/{id}": templateUUID}
 	mockRegistry.On("GetRouteToTemplateMapping").Return(routeMapping)
 
 	// Mock template function that returns a parameterized function
@@ -434,6 +479,84 @@ func TestOptimizedTemplateService_RenderComponent_RouteNotFound(t *testing.T) {
 	mockRouterCtx.AssertExpectations(t)
 }
 
+func TestOptimizedTemplateService_RenderFragment(t *testing.T) {
+	service, mockRegistry, _, _, _ := createTestOTS(t)
+
+	route := interfaces.Route{
+		Path:         "/{locale}/dashboard",
+		TemplateFile: "app/locale_/dashboard/page.templ",
+	}
+	ctx := context.Background()
+	mockRouterCtx := &mockOTSRouterContext{ctx: ctx}
+
+	mockRegistry.On("GetComponentTemplateKey", "app/locale_/dashboard#user_stats").Return("fragment-123", true)
+	mockRegistry.On("GetComponentTemplateKey", "app/locale_/dashboard#user_card").Return("fragment-456", true)
+	mockRegistry.On("GetComponentTemplateKey", mock.Anything).Return("", false)
+	mockRegistry.On("GetTemplateFunction", "fragment-123").Return(func() interface{} {
+		return func() templ.Component {
+			return mockOTSComponent{}
+		}
+	}, true)
+	mockRegistry.On("GetTemplateFunction", "fragment-456").Return(func() interface{} {
+		return func(id string) templ.Component {
+			return mockOTSComponent{}
+		}
+	}, true)
+
+	// Both the component name and the kebab-case element id resolve the fragment
+	for _, fragment := range []string{"UserStats", "user-stats"} {
+		result, err := service.RenderFragment(route, fragment, mockRouterCtx, ctx)
+		assert.NoError(t, err, fragment)
+		assert.Equal(t, mockOTSComponent{}, result, fragment)
+	}
+
+	// Missing fragments, components with parameters and invalid names are rejected
+	for _, fragment := range []string{"missing", "UserCard", "../admin"} {
+		result, err := service.RenderFragment(route, fragment, mockRouterCtx, ctx)
+		assert.Error(t, err, fragment)
+		assert.Nil(t, result, fragment)
+	}
+
+	mockRegistry.AssertNotCalled(t, "GetRouteToTemplateMapping")
+}
+
+func TestOptimizedTemplateService_RenderFragmentRejectsChildPages(t *testing.T) {
+	service, mockRegistry, _, _, _ := createTestOTS(t)
+
+	route := interfaces.Route{
+		Path:         "/{locale}/dashboard",
+		TemplateFile: "app/locale_/dashboard/page.templ",
+	}
+	ctx := context.Background()
+	mockRouterCtx := &mockOTSRouterContext{ctx: ctx}
+
+	// The child page app/locale_/dashboard/admin/page.templ shares the route key space of
+	// dashboard components, but is not a component of the dashboard package
+	mockRegistry.On("GetRouteToTemplateMapping").Return(map[string]string{
+		"/{locale}/dashboard/admin":  "admin-page",
+		"/{locale}/dashboard/layout": "dashboard-layout",
+	}).Maybe()
+	mockRegistry.On("GetComponentTemplateKey", shared.PackageComponentKey(route.TemplateFile, "Stats")).Return("dashboard-stats", true)
+	mockRegistry.On("GetComponentTemplateKey", mock.Anything).Return("", false)
+	mockRegistry.On("GetTemplateFunction", "dashboard-stats").Return(func() interface{} {
+		return func() templ.Component {
+			return mockOTSComponent{}
+		}
+	}, true)
+
+	for _, fragment := range []string{"admin", "Admin", "layout", "page", "error"} {
+		result, err := service.RenderFragment(route, fragment, mockRouterCtx, ctx)
+		assert.Error(t, err, fragment)
+		assert.Nil(t, result, fragment)
+	}
+
+	// Components of the page package still resolve
+	result, err := service.RenderFragment(route, "stats", mockRouterCtx, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, mockOTSComponent{}, result)
+	mockRegistry.AssertNumberOfCalls(t, "GetTemplateFunction", 1)
+}
+
 func TestOptimizedTemplateService_convertLayoutPathToRoute(t *testing.T) {
 	service, _, _, _, _ := createTestOTS(t)
 
@@ -448,6 +571,16 @@ func TestOptimizedTemplateService_convertLayoutPathToRoute(t *testing.T) {
 			layoutPath: "/app/layout.templ",
 			expected:   "/layout",
 		},
+		{
+			name:       "nested layout path",
+			layoutPath: "app/locale_/dashboard/layout.templ",
+			expected:   "/{locale}/dashboard/layout",
+		},
+		{
+			name:       "layout below catch-all",
+			layoutPath: "demo/app/docs/slug___/layout.templ",
+			expected:   "/docs/{slug...}/layout",
+		},
 		{
 			name:        "empty layout path",
 			layoutPath:  "",
@@ -535,6 +668,112 @@ func TestOptimizedTemplateService_isDataServiceTemplate(t *testing.T) {
 	}
 }
 
+func TestOptimizedTemplateService_ExecuteTemplateFunction_NamedParameter(t *testing.T) {
+	service, _, _, _, _ := createTestOTS(t)
+
+	mockRouterCtx := &mockOTSRouterContext{}
+	mockRouterCtx.On("GetURLParam", "slug").Return("hello-world")
+
+	var received string
+	templateFunc := func() interface{} {
+		return func(slug string) templ.Component {
+			received = slug
+			return mockOTSComponent{}
+		}
+	}
+
+	result, err := service.executeTemplateFunction(templateFunc, mockRouterCtx, "/{locale}/blog/{slug}", "template-123")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, "hello-world", received)
+	mockRouterCtx.AssertExpectations(t)
+}
+
+func TestOptimizedTemplateService_ExecuteTemplateFunction_MultipleParameters(t *testing.T) {
+	service, _, _, _, _ := createTestOTS(t)
+
+	mockRouterCtx := &mockOTSRouterContext{}
+	mockRouterCtx.On("GetURLParam", "orgId").Return("acme")
+	mockRouterCtx.On("GetURLParam", "projectId").Return("42")
+
+	var gotOrg, gotProject string
+	templateFunc := func() interface{} {
+		return func(orgId, projectId string) templ.Component {
+			gotOrg, gotProject = orgId, projectId
+			return mockOTSComponent{}
+		}
+	}
+
+	result, err := service.executeTemplateFunction(templateFunc, mockRouterCtx, "/{locale}/org/$orgId/project/{projectId}", "template-123")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, "acme", gotOrg)
+	assert.Equal(t, "42", gotProject)
+	mockRouterCtx.AssertExpectations(t)
+}
+
+func TestOptimizedTemplateService_ExecuteTemplateFunction_ParameterCountMismatch(t *testing.T) {
+	service, _, _, _, _ := createTestOTS(t)
+
+	mockRouterCtx := &mockOTSRouterContext{}
+	templateFunc := func() interface{} {
+		return func(a, b, c string) templ.Component {
+			return mockOTSComponent{}
+		}
+	}
+
+	result, err := service.executeTemplateFunction(templateFunc, mockRouterCtx, "/org/{orgId}", "template-123")
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	var appErr *shared.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, shared.ErrorTypeValidation, appErr.Type)
+	assert.Contains(t, appErr.Message, "template parameter 2 has no matching route parameter")
+}
+
+func TestOptimizedTemplateService_ExecuteTemplateFunction_StaticRouteParameter(t *testing.T) {
+	service, _, _, _, _ := createTestOTS(t)
+
+	// Static routes have no parameter to resolve, no conventional "id" is assumed
+	mockRouterCtx := &mockOTSRouterContext{}
+	templateFunc := func() interface{} {
+		return func(id string) templ.Component {
+			return mockOTSComponent{}
+		}
+	}
+
+	result, err := service.executeTemplateFunction(templateFunc, mockRouterCtx, "/user/123", "template-123")
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	var appErr *shared.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Contains(t, appErr.Message, "template parameter 1 has no matching route parameter")
+	mockRouterCtx.AssertNotCalled(t, "GetURLParam", "id")
+}
+
+func TestOptimizedTemplateService_ExecuteTemplateFunction_UnmatchedRouteParameter(t *testing.T) {
+	service, _, _, _, _ := createTestOTS(t)
+
+	mockRouterCtx := &mockOTSRouterContext{}
+	templateFunc := func() interface{} {
+		return func(orgId string) templ.Component {
+			return mockOTSComponent{}
+		}
+	}
+
+	result, err := service.executeTemplateFunction(templateFunc, mockRouterCtx, "/org/{orgId}/project/{projectId}", "template-123")
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	var appErr *shared.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Contains(t, appErr.Message, "route parameter 'projectId' has no matching template parameter")
+}
+
 func TestOptimizedTemplateService_ClearCache(t *testing.T) {
 	service, _, mockCache, _, _ := createTestOTS(t)
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -82,6 +83,31 @@ func (pv *ParameterValidator) ValidateSingleParameter(
 		}
 	}
 
+	// Validate parameter type if provided
+	if !interfaces.IsValidParameterType(paramConfig.Type) {
+		result.Errors = append(result.Errors, ValidationError{
+			Type:      "INVALID_PARAMETER_TYPE",
+			Message:   fmt.Sprintf("Unknown type '%s' for parameter '%s'", paramConfig.Type, paramName),
+			RoutePath: route.Path,
+			FilePath:  route.TemplateFile,
+			Suggestions: []string{
+				"Use one of: string, int, uuid, date, enum",
+			},
+		})
+	}
+
+	if paramConfig.Type == interfaces.ParameterTypeEnum && len(paramConfig.SupportedValues) == 0 {
+		result.Errors = append(result.Errors, ValidationError{
+			Type:      "MISSING_ENUM_VALUES",
+			Message:   fmt.Sprintf("Enum parameter '%s' has no supported_values", paramName),
+			RoutePath: route.Path,
+			FilePath:  route.TemplateFile,
+			Suggestions: []string{
+				"Add supported_values to the parameter configuration",
+			},
+		})
+	}
+
 	// Log parameter validation completion
 	pv.logger.Debug("Parameter validation completed",
 		zap.String("parameter", paramName),
@@ -133,19 +159,7 @@ func (pv *ParameterValidator) ValidateParameterInheritance(route *interfaces.Rou
 
 // extractDirectoryParameters extracts parameter names from route path
 func (pv *ParameterValidator) extractDirectoryParameters(routePath string) []string {
-	var params []string
-	parts := strings.Split(routePath, "/")
-
-	for _, part := range parts {
-		if strings.HasPrefix(part, "$") {
-			paramName := strings.TrimPrefix(part, "$")
-			if paramName != "" {
-				params = append(params, paramName)
-			}
-		}
-	}
-
-	return params
+	return shared.ExtractRouteParamNames(routePath)
 }
 
 // parameterExistsInRoute checks if a parameter exists in the route path
@@ -18,6 +18,10 @@ type mockConfigService struct{}
 func (m *mockConfigService) GetRouterEnableTrailingSlash() bool     { return true }
 func (m *mockConfigService) GetRouterEnableSlashRedirect() bool     { return true }
 func (m *mockConfigService) GetRouterEnableMethodNotAllowed() bool  { return true }
+func (m *mockConfigService) GetRouterParameterValidationStatus() int { return 404 }
+func (m *mockConfigService) GetRouterValidationMode() string { return "warn" }
+func (m *mockConfigService) GetRouterBasePath() string { return "" }
+func (m *mockConfigService) GetRouterRenderMode() string { return "direct" }
 
 // Implement all required ConfigService methods
 func (m *mockConfigService) GetLayoutRootDirectory() string            { return "app" }
@@ -51,6 +55,7 @@ func (m *mockConfigService) IsEmailVerificationRequired() bool         { return
 func (m *mockConfigService) GetVerificationTokenExpiry() time.Duration { return 24 * time.Hour }
 func (m *mockConfigService) GetSessionCookieName() string              { return "session" }
 func (m *mockConfigService) GetSessionExpiry() time.Duration           { return 24 * time.Hour }
+func (m *mockConfigService) GetSessionRememberExpiry() time.Duration   { return 30 * 24 * time.Hour }
 func (m *mockConfigService) IsSessionSecure() bool                     { return false }
 func (m *mockConfigService) IsSessionHttpOnly() bool                   { return true }
 func (m *mockConfigService) GetSessionSameSite() string                { return "Lax" }
@@ -65,15 +70,20 @@ func (m *mockConfigService) GetSignInRoute() string                    { return
 func (m *mockConfigService) GetSignInSuccessRoute() string             { return "/dashboard" }
 func (m *mockConfigService) GetSignUpSuccessRoute() string             { return "/welcome" }
 func (m *mockConfigService) GetSignOutSuccessRoute() string            { return "/" }
+func (m *mockConfigService) IsCSRFEnabled() bool                       { return false }
 func (m *mockConfigService) GetCSRFSecret() string                     { return "secret" }
 func (m *mockConfigService) IsCSRFSecure() bool                        { return false }
 func (m *mockConfigService) IsCSRFHttpOnly() bool                      { return true }
 func (m *mockConfigService) GetCSRFSameSite() string                   { return "Lax" }
+func (m *mockConfigService) GetCSRFExemptPaths() []string              { return nil }
 func (m *mockConfigService) IsRateLimitEnabled() bool                  { return false }
 func (m *mockConfigService) GetRateLimitRequests() int                 { return 100 }
+func (m *mockConfigService) IsRateLimitAllRoutesEnabled() bool         { return false }
+func (m *mockConfigService) GetTrustedProxies() []string               { return nil }
 func (m *mockConfigService) AreSecurityHeadersEnabled() bool           { return false }
 func (m *mockConfigService) IsHSTSEnabled() bool                       { return false }
 func (m *mockConfigService) GetHSTSMaxAge() int                        { return 31536000 }
+func (m *mockConfigService) GetContentSecurityPolicy() string          { return "" }
 func (m *mockConfigService) GetLogLevel() string                       { return "info" }
 func (m *mockConfigService) GetLogFormat() string                      { return "json" }
 func (m *mockConfigService) GetLogOutput() string                      { return "stdout" }
@@ -459,3 +469,48 @@ func TestParameterValidator_IsConfigForRoute(t *testing.T) {
 	}
 }
 
+
+func TestParameterValidator_ValidateSingleParameterType(t *testing.T) {
+	injector := do.New()
+	defer injector.Shutdown()
+
+	do.Provide(injector, func(i do.Injector) (*zap.Logger, error) {
+		return zap.NewNop(), nil
+	})
+	do.Provide(injector, func(i do.Injector) (interfaces.ConfigService, error) {
+		return &mockConfigService{}, nil
+	})
+
+	validator, err := NewParameterValidator(injector)
+	require.NoError(t, err)
+
+	route := &interfaces.Route{
+		Path:         "/user/{id}",
+		TemplateFile: "app/user/id_/page.templ",
+	}
+
+	tests := []struct {
+		name          string
+		paramConfig   *interfaces.DynamicParameterConfig
+		expectedTypes []string
+	}{
+		{"int type", &interfaces.DynamicParameterConfig{Type: interfaces.ParameterTypeInt}, nil},
+		{"date type with format", &interfaces.DynamicParameterConfig{Type: interfaces.ParameterTypeDate, Format: "20060102"}, nil},
+		{"enum with values", &interfaces.DynamicParameterConfig{Type: interfaces.ParameterTypeEnum, SupportedValues: []string{"a"}}, nil},
+		{"unknown type", &interfaces.DynamicParameterConfig{Type: "float"}, []string{"INVALID_PARAMETER_TYPE"}},
+		{"enum without values", &interfaces.DynamicParameterConfig{Type: interfaces.ParameterTypeEnum}, []string{"MISSING_ENUM_VALUES"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &ValidationResult{}
+			validator.ValidateSingleParameter("id", tt.paramConfig, route, &interfaces.ConfigFile{}, []string{"id"}, result)
+
+			var errorTypes []string
+			for _, validationErr := range result.Errors {
+				errorTypes = append(errorTypes, validationErr.Type)
+			}
+			assert.Equal(t, tt.expectedTypes, errorTypes)
+		})
+	}
+}
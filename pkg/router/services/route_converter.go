@@ -3,6 +3,7 @@ package services
 import (
 	"strings"
 
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -33,6 +34,8 @@ func (rc *routeConverter) GenerateTemplateKey(templateFile string) string {
 	// Convert app/page.templ -> /
 	// Convert app/locale_/admin/page.templ -> /$locale/admin
 	// Convert app/locale_/page.templ -> /$locale
+	// Convert app/org/orgId_/project/projectId_/page.templ -> /org/$orgId/project/$projectId
+	// Convert app/docs/slug___/page.templ -> /docs/$slug...
 
 	// Remove root directory prefix and ".templ" suffix (library-agnostic)
 	// Extract root directory from config or use first path segment
@@ -49,11 +52,17 @@ func (rc *routeConverter) GenerateTemplateKey(templateFile string) string {
 	// Remove "/page" suffix for route mapping
 	key = strings.TrimSuffix(key, "/page")
 
-	// Convert locale_ to $locale for route matching
-	key = strings.ReplaceAll(key, "/locale_", "/$locale")
-
-	// Convert id_ to $id for dynamic route matching
-	key = strings.ReplaceAll(key, "/id_", "/$id")
+	// Convert every name_ directory (locale_, id_, orgId_, ...) to $name
+	// and catch-all directories (slug___, slug____) to $slug... and $slug...?
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		if paramName, optional, ok := shared.DirectoryToCatchAll(segment); ok {
+			segments[i] = "$" + strings.Trim(shared.CatchAllPatternSegment(paramName, optional), "{}")
+		} else if paramName, ok := shared.DirectoryToRouteParam(segment); ok {
+			segments[i] = "$" + paramName
+		}
+	}
+	key = strings.Join(segments, "/")
 
 	// Special case: if empty, it's the root route
 	if key == "" {
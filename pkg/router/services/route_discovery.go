@@ -2,6 +2,8 @@ package services
 
 import (
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
@@ -76,14 +78,18 @@ func (rd *routeDiscoveryImpl) DiscoverRoutes(scanPath string) ([]interfaces.Rout
 			zap.String("data_service_interface", dataServiceInterface))
 
 		// Create route object
+		templateFile := rd.generateTemplateFilePathFromPattern(routePattern)
+		_, hasAction := rd.templateRegistry.GetAction(shared.PageActionKey(templateFile))
+
 		route := interfaces.Route{
 			Path:                 routePattern,
-			TemplateFile:         rd.generateTemplateFilePathFromPattern(routePattern),
-			IsDynamic:            strings.Contains(routePattern, "$"),
+			TemplateFile:         templateFile,
+			IsDynamic:            shared.IsDynamicRoutePattern(routePattern),
 			Handler:              rd.generateHandlerName(routePattern),
 			Precedence:           rd.calculateRoutePrecedence(routePattern),
 			RequiresDataService:  requiresDataService,
 			DataServiceInterface: dataServiceInterface,
+			HasAction:            hasAction,
 		}
 
 		routes = append(routes, route)
@@ -94,9 +100,21 @@ func (rd *routeDiscoveryImpl) DiscoverRoutes(scanPath string) ([]interfaces.Rout
 			zap.String("file", route.TemplateFile),
 			zap.Bool("dynamic", route.IsDynamic),
 			zap.Bool("requires_data_service", route.RequiresDataService),
-			zap.String("data_service_interface", route.DataServiceInterface))
+			zap.String("data_service_interface", route.DataServiceInterface),
+			zap.Bool("has_action", route.HasAction))
 	}
 
+	// Route handlers of route.go files answer on the route of their directory like pages
+	routes = append(routes, rd.discoverRouteHandlers()...)
+
+	// Map iteration order is random; order routes by precedence so registration is deterministic
+	sort.SliceStable(routes, func(a, b int) bool {
+		if routes[a].Precedence != routes[b].Precedence {
+			return routes[a].Precedence > routes[b].Precedence
+		}
+		return routes[a].Path < routes[b].Path
+	})
+
 	rd.logger.Info("Route discovery completed using template registry",
 		zap.String("scan_path", scanPath),
 		zap.Int("routes_found", len(routes)))
@@ -104,11 +122,48 @@ func (rd *routeDiscoveryImpl) DiscoverRoutes(scanPath string) ([]interfaces.Rout
 	return routes, nil
 }
 
+// discoverRouteHandlers creates the routes of the route.go handlers in the template registry
+func (rd *routeDiscoveryImpl) discoverRouteHandlers() []interfaces.Route {
+	var routes []interfaces.Route
+
+	for routePattern, handlerKey := range rd.templateRegistry.GetRouteHandlerMapping() {
+		if _, exists := rd.templateRegistry.GetRouteHandler(handlerKey); !exists {
+			rd.logger.Warn("Route handler not available for route",
+				zap.String("route", routePattern),
+				zap.String("handler", handlerKey))
+			continue
+		}
+
+		route := interfaces.Route{
+			Path:         routePattern,
+			TemplateFile: rd.generateFilePathFromPattern(routePattern, shared.RouteHandlerFileName),
+			IsDynamic:    shared.IsDynamicRoutePattern(routePattern),
+			Handler:      rd.generateHandlerName(routePattern),
+			Precedence:   rd.calculateRoutePrecedence(routePattern),
+			IsHandler:    true,
+		}
+
+		routes = append(routes, route)
+
+		rd.logger.Info("Route handler discovered from template registry",
+			zap.String("pattern", routePattern),
+			zap.String("handler", handlerKey),
+			zap.String("file", route.TemplateFile),
+			zap.Bool("dynamic", route.IsDynamic))
+	}
+
+	return routes
+}
+
 // generateTemplateFilePathFromPattern generates a template file path from a route pattern
 func (rd *routeDiscoveryImpl) generateTemplateFilePathFromPattern(routePattern string) string {
+	return rd.generateFilePathFromPattern(routePattern, "page"+rd.config.GetTemplateExtension())
+}
+
+// generateFilePathFromPattern generates the path of fileName in the directory of a route pattern
+func (rd *routeDiscoveryImpl) generateFilePathFromPattern(routePattern, fileName string) string {
 	// Get configurable template root directory
 	templateRoot := rd.config.GetLayoutRootDirectory()
-	templateExtension := rd.config.GetTemplateExtension()
 
 	// Convert route pattern to file path
 	// Example: "/en/dashboard" -> "app/locale_/dashboard/page.templ"
@@ -123,25 +178,22 @@ func (rd *routeDiscoveryImpl) generateTemplateFilePathFromPattern(routePattern s
 			continue
 		}
 
-		// Handle dynamic parameters
-		if strings.HasPrefix(part, "$") {
-			// Convert $id to id_
-			paramName := strings.TrimPrefix(part, "$")
+		// Handle catch-all parameters - {slug...} becomes slug___, {slug...?} becomes slug____
+		if paramName, optional, ok := shared.ParseCatchAllSegment(part); ok {
+			pathParts = append(pathParts, shared.CatchAllDirectory(paramName, optional))
+		} else if paramName, ok := shared.ParseRouteParamSegment(part); ok {
+			// Handle dynamic parameters - both $name and {name} become name_
 			pathParts = append(pathParts, paramName+"_")
-		} else if part == "{locale}" || (len(part) == 2 && (part == "en" || part == "de" || part == "fr" || part == "es")) {
-			// Handle locale parameters - both placeholder {locale} and actual locale codes
+		} else if len(part) == 2 && (part == "en" || part == "de" || part == "fr" || part == "es") {
+			// Handle actual locale codes
 			pathParts = append(pathParts, "locale_")
-		} else if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
-			// Handle other dynamic parameters like {id}
-			paramName := strings.Trim(part, "{}")
-			pathParts = append(pathParts, paramName+"_")
 		} else {
 			pathParts = append(pathParts, part)
 		}
 	}
 
-	// Add page template file
-	pathParts = append(pathParts, "page"+templateExtension)
+	// Add the page template or route handler file
+	pathParts = append(pathParts, fileName)
 
 	return filepath.Join(templateRoot, filepath.Join(pathParts...))
 }
@@ -160,9 +212,8 @@ func (rd *routeDiscoveryImpl) generateHandlerName(routePattern string) string {
 			continue
 		}
 
-		if strings.HasPrefix(part, "$") {
-			// Convert $id to Id
-			paramName := strings.TrimPrefix(part, "$")
+		if paramName, ok := shared.ParseRouteParamSegment(part); ok {
+			// Convert $id or {id} to Id
 			handlerParts = append(handlerParts, strings.Title(paramName))
 		} else if len(part) == 2 && (part == "en" || part == "de" || part == "fr" || part == "es") {
 			// Handle locale
@@ -184,7 +235,10 @@ func (rd *routeDiscoveryImpl) calculateRoutePrecedence(routePattern string) int
 	parts := strings.Split(strings.Trim(routePattern, "/"), "/")
 
 	for _, part := range parts {
-		if strings.HasPrefix(part, "$") {
+		if _, _, ok := shared.ParseCatchAllSegment(part); ok {
+			// Catch-all parameter matches any depth and has the lowest precedence
+			precedence -= 50
+		} else if _, ok := shared.ParseRouteParamSegment(part); ok {
 			// Dynamic parameter reduces precedence
 			precedence -= 10
 		} else {
@@ -296,8 +350,14 @@ func (rd *routeDiscoveryImpl) isLayoutTemplate(path string) bool {
 }
 
 // isErrorTemplate checks if a template file is an error template
+// (error.templ or a status error template like error.404.templ and not-found.templ)
 func (rd *routeDiscoveryImpl) isErrorTemplate(path string) bool {
-	return strings.Contains(path, "error.templ")
+	templateName, found := strings.CutSuffix(filepath.Base(path), ".templ")
+	if !found {
+		return false
+	}
+	_, ok := shared.ErrorTemplateStatus(templateName)
+	return ok
 }
 
 // createLayoutFromTemplate creates a layout template from a template file
@@ -350,6 +410,12 @@ func (rd *routeDiscoveryImpl) createErrorTemplateFromTemplate(templatePath, scan
 
 // extractErrorType extracts the error type from an error template path
 func (rd *routeDiscoveryImpl) extractErrorType(templatePath string) string {
+	// Status error templates name their status, e.g. not-found.templ -> 404
+	templateName := strings.TrimSuffix(filepath.Base(templatePath), ".templ")
+	if status, ok := shared.ErrorTemplateStatus(templateName); ok && status != 0 {
+		return strconv.Itoa(status)
+	}
+
 	// Look for numeric error codes in the path
 	parts := strings.Split(templatePath, "/")
 	for _, part := range parts {
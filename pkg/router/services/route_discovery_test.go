@@ -1,6 +1,7 @@
 package services
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
@@ -70,6 +71,7 @@ func (m *mockRouteDiscoveryConfigService) IsEmailVerificationRequired() bool { r
 func (m *mockRouteDiscoveryConfigService) GetVerificationTokenExpiry() time.Duration { return 24 * time.Hour }
 func (m *mockRouteDiscoveryConfigService) GetSessionCookieName() string { return "session" }
 func (m *mockRouteDiscoveryConfigService) GetSessionExpiry() time.Duration { return 24 * time.Hour }
+func (m *mockRouteDiscoveryConfigService) GetSessionRememberExpiry() time.Duration { return 30 * 24 * time.Hour }
 func (m *mockRouteDiscoveryConfigService) IsSessionSecure() bool { return false }
 func (m *mockRouteDiscoveryConfigService) IsSessionHTTPOnly() bool { return true }
 func (m *mockRouteDiscoveryConfigService) GetSessionSameSite() string { return "lax" }
@@ -89,14 +91,19 @@ func (m *mockRouteDiscoveryConfigService) GetFromEmail() string { return "norepl
 func (m *mockRouteDiscoveryConfigService) GetFromName() string { return "App" }
 func (m *mockRouteDiscoveryConfigService) GetReplyToEmail() string { return "" }
 func (m *mockRouteDiscoveryConfigService) IsEmailDummyModeEnabled() bool { return true }
+func (m *mockRouteDiscoveryConfigService) IsCSRFEnabled() bool { return false }
 func (m *mockRouteDiscoveryConfigService) GetCSRFSecret() string { return "secret" }
 func (m *mockRouteDiscoveryConfigService) IsCSRFSecure() bool { return false }
 func (m *mockRouteDiscoveryConfigService) IsCSRFHTTPOnly() bool { return true }
 func (m *mockRouteDiscoveryConfigService) GetCSRFSameSite() string { return "strict" }
+func (m *mockRouteDiscoveryConfigService) GetCSRFExemptPaths() []string { return nil }
 func (m *mockRouteDiscoveryConfigService) IsRateLimitEnabled() bool { return false }
 func (m *mockRouteDiscoveryConfigService) GetRateLimitRequests() int { return 100 }
+func (m *mockRouteDiscoveryConfigService) IsRateLimitAllRoutesEnabled() bool { return false }
+func (m *mockRouteDiscoveryConfigService) GetTrustedProxies() []string { return nil }
 func (m *mockRouteDiscoveryConfigService) IsHSTSEnabled() bool { return false }
 func (m *mockRouteDiscoveryConfigService) GetHSTSMaxAge() int { return 31536000 }
+func (m *mockRouteDiscoveryConfigService) GetContentSecurityPolicy() string { return "" }
 func (m *mockRouteDiscoveryConfigService) GetLogLevel() string { return "info" }
 func (m *mockRouteDiscoveryConfigService) GetLogFormat() string { return "json" }
 func (m *mockRouteDiscoveryConfigService) GetLogOutput() string { return "stdout" }
@@ -120,7 +127,8 @@ func (m *mockFileSystemChecker) WalkDirectory(root string, walkFn func(path stri
 }
 
 type mockTemplateRegistry struct {
-	routeMapping map[string]string
+	routeMapping   map[string]string
+	handlerMapping map[string]string
 }
 
 func (m *mockTemplateRegistry) GetRouteToTemplateMapping() map[string]string {
@@ -156,6 +164,29 @@ func (m *mockTemplateRegistry) GetTemplateByRoute(route string) (templ.Component
 	return nil, nil
 }
 
+func (m *mockTemplateRegistry) GetComponentTemplateKey(componentKey string) (string, bool) {
+	return "", false
+}
+
+// GetAction retrieves the Action function of a page package
+func (m *mockTemplateRegistry) GetAction(actionKey string) (interfaces.ActionFunc, bool) {
+	return nil, false
+}
+
+func (m *mockTemplateRegistry) GetRouteHandlerMapping() map[string]string {
+	return m.handlerMapping
+}
+
+// GetRouteHandler serves every handler key of the handler mapping
+func (m *mockTemplateRegistry) GetRouteHandler(handlerKey string) (http.Handler, bool) {
+	for _, key := range m.handlerMapping {
+		if key == handlerKey {
+			return http.NotFoundHandler(), true
+		}
+	}
+	return nil, false
+}
+
 func (m *mockTemplateRegistry) RequiresDataService(key string) bool {
 	return false
 }
@@ -214,6 +245,43 @@ func TestDiscoverRoutes(t *testing.T) {
 	}
 }
 
+func TestDiscoverRouteHandlers(t *testing.T) {
+	injector := do.New()
+	do.ProvideValue[interfaces.ConfigService](injector, &mockRouteDiscoveryConfigService{})
+	do.ProvideValue[*zap.Logger](injector, zap.NewNop())
+	do.ProvideValue[middleware.FileSystemChecker](injector, &mockFileSystemChecker{})
+	do.ProvideValue[interfaces.TemplateRegistry](injector, &mockTemplateRegistry{
+		routeMapping: map[string]string{},
+		handlerMapping: map[string]string{
+			"/{locale}/reports/export": "app/locale_/reports/export#handler",
+		},
+	})
+
+	discovery, err := NewRouteDiscovery(injector)
+	if err != nil {
+		t.Fatalf("Failed to create route discovery: %v", err)
+	}
+
+	routes, err := discovery.DiscoverRoutes("app")
+	if err != nil {
+		t.Fatalf("DiscoverRoutes() returned error: %v", err)
+	}
+	if len(routes) != 1 {
+		t.Fatalf("DiscoverRoutes() returned %d routes, want 1", len(routes))
+	}
+
+	route := routes[0]
+	if !route.IsHandler {
+		t.Errorf("route %s is not a route handler", route.Path)
+	}
+	if route.TemplateFile != "app/locale_/reports/export/route.go" {
+		t.Errorf("route handler file = %s, want app/locale_/reports/export/route.go", route.TemplateFile)
+	}
+	if route.Path != "/{locale}/reports/export" || route.RequiresDataService || route.HasAction {
+		t.Errorf("unexpected route handler route: %+v", route)
+	}
+}
+
 func TestGenerateTemplateFilePathFromPattern(t *testing.T) {
 	injector := createTestContainer()
 	discovery, err := NewRouteDiscovery(injector)
@@ -254,6 +322,24 @@ func TestGenerateTemplateFilePathFromPattern(t *testing.T) {
 			expectedPath: "app/locale_/user/id_/page.templ",
 			description:  "Dynamic ID should be converted to id_ directory",
 		},
+		{
+			name:         "Multiple named parameters",
+			routePattern: "/{locale}/org/{orgId}/project/$projectId",
+			expectedPath: "app/locale_/org/orgId_/project/projectId_/page.templ",
+			description:  "Any named parameter should be converted to its name_ directory",
+		},
+		{
+			name:         "Catch-all parameter",
+			routePattern: "/docs/{slug...}",
+			expectedPath: "app/docs/slug___/page.templ",
+			description:  "Catch-all should be converted to slug___ directory",
+		},
+		{
+			name:         "Optional catch-all parameter",
+			routePattern: "/{locale}/docs/{slug...?}",
+			expectedPath: "app/locale_/docs/slug____/page.templ",
+			description:  "Optional catch-all should be converted to slug____ directory",
+		},
 		{
 			name:         "Static route",
 			routePattern: "/login",
@@ -285,6 +371,27 @@ func TestGenerateTemplateFilePathFromPattern(t *testing.T) {
 	}
 }
 
+func TestCalculateRoutePrecedenceNamedParameters(t *testing.T) {
+	injector := createTestContainer()
+	discovery, err := NewRouteDiscovery(injector)
+	if err != nil {
+		t.Fatalf("Failed to create route discovery: %v", err)
+	}
+
+	impl := discovery.(*routeDiscoveryImpl)
+
+	static := impl.calculateRoutePrecedence("/{locale}/org/new")
+	dynamic := impl.calculateRoutePrecedence("/{locale}/org/{orgId}")
+	legacy := impl.calculateRoutePrecedence("/$locale/org/$orgId")
+
+	if static <= dynamic {
+		t.Errorf("static route precedence %d should exceed dynamic route precedence %d", static, dynamic)
+	}
+	if dynamic != legacy {
+		t.Errorf("{name} and $name syntax should have equal precedence, got %d and %d", dynamic, legacy)
+	}
+}
+
 func TestI18nPlaceholderFix(t *testing.T) {
 	injector := createTestContainer()
 	discovery, err := NewRouteDiscovery(injector)
@@ -356,3 +463,7 @@ func (m *mockRouteDiscoveryConfigService) GetSignInRoute() string { return "/log
 func (m *mockRouteDiscoveryConfigService) GetRouterEnableTrailingSlash() bool     { return true }
 func (m *mockRouteDiscoveryConfigService) GetRouterEnableSlashRedirect() bool     { return true }
 func (m *mockRouteDiscoveryConfigService) GetRouterEnableMethodNotAllowed() bool  { return true }
+func (m *mockRouteDiscoveryConfigService) GetRouterParameterValidationStatus() int { return 404 }
+func (m *mockRouteDiscoveryConfigService) GetRouterValidationMode() string { return "warn" }
+func (m *mockRouteDiscoveryConfigService) GetRouterBasePath() string { return "" }
+func (m *mockRouteDiscoveryConfigService) GetRouterRenderMode() string { return "direct" }
@@ -5,7 +5,9 @@ import (
 	"strings"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/router/metadata"
 	"github.com/denkhaus/templ-router/pkg/router/middleware"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -14,6 +16,7 @@ type RouteValidator interface {
 	ValidateTemplateFileExists(route *interfaces.Route, result *ValidationResult)
 	ValidateRouteConfig(route *interfaces.Route, config *interfaces.ConfigFile, result *ValidationResult)
 	ValidateRouteConflicts(routes []interfaces.Route, result *ValidationResult)
+	ValidateRedirects(rules []interfaces.RedirectRule, routes []interfaces.Route, result *ValidationResult)
 }
 
 // routeValidator handles route-specific validation logic
@@ -81,6 +84,11 @@ func (rv *routeValidator) ValidateRouteConfig(route *interfaces.Route, config *i
 		rv.validateRouteSettings(route, config, result)
 	}
 
+	// Validate layout selection
+	if config.LayoutSettings != nil {
+		rv.validateLayoutSettings(route, config, result)
+	}
+
 	rv.logger.Debug("Route config validated",
 		zap.String("route", route.Path),
 		zap.Bool("has_config", config != nil))
@@ -112,6 +120,9 @@ func (rv *routeValidator) ValidateRouteConflicts(routes []interfaces.Route, resu
 
 	// Check for dynamic route conflicts
 	rv.validateDynamicRouteConflicts(routes, result)
+
+	// Check for catch-all routes shadowing sibling routes
+	rv.validateCatchAllConflicts(routes, result)
 }
 
 // validateRouteSettings validates specific route configuration settings
@@ -128,6 +139,40 @@ func (rv *routeValidator) validateRouteSettings(route *interfaces.Route, config
 		zap.Bool("has_metadata", config.RouteMetadata != nil))
 }
 
+// validateLayoutSettings checks that a layout selected in metadata exists
+func (rv *routeValidator) validateLayoutSettings(route *interfaces.Route, config *interfaces.ConfigFile, result *ValidationResult) {
+	switch config.LayoutSettings.(type) {
+	case string, map[string]interface{}, map[interface{}]interface{}:
+	default:
+		result.Errors = append(result.Errors, ValidationError{
+			Type:      "INVALID_LAYOUT_SETTINGS",
+			Message:   fmt.Sprintf("Layout setting must be \"none\", a layout name or a map, got %v", config.LayoutSettings),
+			RoutePath: route.Path,
+			FilePath:  config.FilePath,
+		})
+		return
+	}
+
+	settings := metadata.NewMetadataSettingsParser().ParseLayoutSettings(config.LayoutSettings)
+	if settings.None || settings.Name == "" {
+		return
+	}
+
+	layoutPath := middleware.NamedLayoutPath(rv.config, settings.Name)
+	if layoutPath == "" || !rv.fileSystem.FileExists(layoutPath) {
+		result.Errors = append(result.Errors, ValidationError{
+			Type:      "LAYOUT_NOT_FOUND",
+			Message:   fmt.Sprintf("Layout %q selected in metadata does not exist: %s", settings.Name, layoutPath),
+			RoutePath: route.Path,
+			FilePath:  config.FilePath,
+			Suggestions: []string{
+				"Use the directory of a layout below the layout root, e.g. \"print\" for print/layout.templ",
+				"Use \"none\" to render the page without a layout",
+			},
+		})
+	}
+}
+
 // normalizeRoutePath normalizes a route path for comparison
 func (rv *routeValidator) normalizeRoutePath(path string) string {
 	// Remove leading/trailing slashes and normalize
@@ -142,6 +187,10 @@ func (rv *routeValidator) normalizeRoutePath(path string) string {
 func (rv *routeValidator) validateDynamicRouteConflicts(routes []interfaces.Route, result *ValidationResult) {
 	for i := 0; i < len(routes); i++ {
 		for j := i + 1; j < len(routes); j++ {
+			// Catch-all routes are checked separately by validateCatchAllConflicts
+			if rv.isCatchAllRoute(routes[i].Path) || rv.isCatchAllRoute(routes[j].Path) {
+				continue
+			}
 			if rv.routesAreAmbiguous(routes[i].Path, routes[j].Path) {
 				result.Errors = append(result.Errors, ValidationError{
 					Type:      "AMBIGUOUS_ROUTES",
@@ -159,23 +208,256 @@ func (rv *routeValidator) validateDynamicRouteConflicts(routes []interfaces.Rout
 	}
 }
 
+// validateCatchAllConflicts checks catch-all routes against every other route.
+// Two catch-alls on the same section, or an optional catch-all and a page on its section
+// root, are errors. Routes below a catch-all section are reported as shadowing warnings
+// because they take precedence over the catch-all for the paths they match.
+func (rv *routeValidator) validateCatchAllConflicts(routes []interfaces.Route, result *ValidationResult) {
+	for i := range routes {
+		base, _, optional, ok := shared.SplitCatchAllPattern(routes[i].Path)
+		if !ok {
+			continue
+		}
+
+		for j := range routes {
+			if i == j {
+				continue
+			}
+			other := routes[j].Path
+
+			if otherBase, _, _, otherIsCatchAll := shared.SplitCatchAllPattern(other); otherIsCatchAll {
+				// Report each pair of catch-alls once
+				if j > i && rv.routesAreAmbiguous(base, otherBase) {
+					result.Errors = append(result.Errors, ValidationError{
+						Type:      "AMBIGUOUS_CATCH_ALL",
+						Message:   fmt.Sprintf("Catch-all routes are ambiguous: %s and %s", routes[i].Path, other),
+						RoutePath: routes[i].Path,
+						FilePath:  routes[i].TemplateFile,
+						Suggestions: []string{
+							"Keep a single catch-all per section",
+							"Add static path segments",
+						},
+					})
+				}
+				continue
+			}
+
+			if optional && rv.routesAreAmbiguous(base, other) {
+				result.Errors = append(result.Errors, ValidationError{
+					Type:      "CATCH_ALL_CONFLICT",
+					Message:   fmt.Sprintf("Optional catch-all route %s also matches section root served by %s", routes[i].Path, other),
+					RoutePath: routes[i].Path,
+					FilePath:  routes[i].TemplateFile,
+					Suggestions: []string{
+						"Use a required catch-all (slug___) instead",
+						"Remove the page at the section root",
+					},
+				})
+				continue
+			}
+
+			if rv.routeIsBelow(other, base) {
+				result.Warnings = append(result.Warnings, ValidationWarning{
+					Type:      "CATCH_ALL_SHADOWING",
+					Message:   fmt.Sprintf("Catch-all route %s overlaps sibling route %s; the sibling takes precedence for the paths it matches", routes[i].Path, other),
+					RoutePath: routes[i].Path,
+					FilePath:  routes[i].TemplateFile,
+					Suggestions: []string{
+						"Move the sibling route out of the catch-all section",
+						"Handle the sibling path inside the catch-all template",
+					},
+				})
+			}
+		}
+	}
+}
+
+// isCatchAllRoute checks if a route ends in a catch-all segment
+func (rv *routeValidator) isCatchAllRoute(path string) bool {
+	_, _, _, ok := shared.SplitCatchAllPattern(path)
+	return ok
+}
+
+// routeIsBelow checks if path lies strictly below the section base (dynamic segments match anything)
+func (rv *routeValidator) routeIsBelow(path, base string) bool {
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+	baseParts := strings.Split(strings.Trim(base, "/"), "/")
+	if strings.Trim(base, "/") == "" {
+		baseParts = nil
+	}
+
+	if len(pathParts) <= len(baseParts) {
+		return false
+	}
+
+	prefix := "/" + strings.Join(pathParts[:len(baseParts)], "/")
+	return rv.routesAreAmbiguous(prefix, base)
+}
+
 // routesAreAmbiguous checks if two routes could conflict
 func (rv *routeValidator) routesAreAmbiguous(path1, path2 string) bool {
-	parts1 := strings.Split(strings.Trim(path1, "/"), "/")
-	parts2 := strings.Split(strings.Trim(path2, "/"), "/")
+	return shared.RoutePatternsOverlap(path1, path2)
+}
+
+// ValidateRedirects checks redirect and rewrite rules for invalid settings, loops and
+// rewrites without an internal target
+func (rv *routeValidator) ValidateRedirects(rules []interfaces.RedirectRule, routes []interfaces.Route, result *ValidationResult) {
+	for _, rule := range rules {
+		if rule.From == "" || rule.To == "" {
+			result.AddError("INVALID_REDIRECT",
+				fmt.Sprintf("Redirect rule needs both from and to (from: %q, to: %q)", rule.From, rule.To),
+				rule.Source, rule.From,
+				"Add the missing from/to pattern")
+			continue
+		}
+
+		if !rule.Rewrite && !interfaces.IsValidRedirectStatus(rule.Status) {
+			result.AddError("INVALID_REDIRECT_STATUS",
+				fmt.Sprintf("Unsupported redirect status %d for %s", rule.Status, rule.From),
+				rule.Source, rule.From,
+				"Use 301, 302, 307 or 308")
+		}
+
+		if rule.Rewrite && !rv.rewriteTargetExists(rule.To, routes) {
+			result.AddError("REWRITE_TARGET_NOT_FOUND",
+				fmt.Sprintf("Rewrite target %s does not match any page route", rule.To),
+				rule.Source, rule.From,
+				"Rewrite to an existing page route",
+				"Use a redirect for external targets")
+		}
+
+		// Chi prefers static segments, so only identical patterns make the page win for GET requests
+		for _, route := range routes {
+			if rv.normalizeRoutePattern(route.Path) == rv.normalizeRoutePattern(rule.From) {
+				result.AddWarning("REDIRECT_SHADOWS_ROUTE",
+					fmt.Sprintf("Page route %s takes precedence over the redirect from %s for GET requests", route.Path, rule.From),
+					rule.Source, rule.From,
+					"Remove the page or the redirect")
+				break
+			}
+		}
+	}
+
+	rv.validateRedirectLoops(rules, result)
+}
+
+// validateRedirectLoops reports every chain of rules that leads back to one of its sources
+func (rv *routeValidator) validateRedirectLoops(rules []interfaces.RedirectRule, result *ValidationResult) {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make([]int, len(rules))
+
+	var visit func(i int, chain []int)
+	visit = func(i int, chain []int) {
+		state[i] = visiting
+		chain = append(chain, i)
+
+		for j := range rules {
+			if rules[j].From == "" || !rv.redirectTargetMatches(rules[i].To, rules[j].From) {
+				continue
+			}
+
+			if state[j] == visiting {
+				// Cycle found: report the part of the chain starting at j
+				var patterns []string
+				for k := len(chain) - 1; k >= 0; k-- {
+					patterns = append([]string{rules[chain[k]].From}, patterns...)
+					if chain[k] == j {
+						break
+					}
+				}
+				patterns = append(patterns, rules[j].From)
+
+				result.AddError("REDIRECT_LOOP",
+					fmt.Sprintf("Redirect loop: %s", strings.Join(patterns, " -> ")),
+					rules[j].Source, rules[j].From,
+					"Point one of the rules at a page route")
+				continue
+			}
+
+			if state[j] == unvisited {
+				visit(j, chain)
+			}
+		}
 
-	if len(parts1) != len(parts2) {
+		state[i] = done
+	}
+
+	for i := range rules {
+		if state[i] == unvisited && rules[i].From != "" && rules[i].To != "" {
+			visit(i, nil)
+		}
+	}
+}
+
+// normalizeRoutePattern replaces every dynamic segment with a placeholder so patterns
+// that only differ in parameter names compare equal
+func (rv *routeValidator) normalizeRoutePattern(pattern string) string {
+	segments := strings.Split(shared.ToChiRoutePattern(pattern), "/")
+	for i, segment := range segments {
+		if _, ok := shared.ParseRouteParamSegment(segment); ok {
+			segments[i] = "{}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// rewriteTargetExists checks if a rewrite target is served by a page route
+func (rv *routeValidator) rewriteTargetExists(target string, routes []interfaces.Route) bool {
+	for _, route := range routes {
+		if rv.redirectTargetMatches(target, route.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// redirectTargetMatches checks if a target path can be matched by a route or redirect pattern.
+// Dynamic pattern segments match anything, while dynamic target segments only match dynamic
+// pattern segments (e.g., /{locale}/docs never reaches /old-docs). External targets never match.
+func (rv *routeValidator) redirectTargetMatches(target, pattern string) bool {
+	if strings.Contains(target, "://") {
 		return false
 	}
+	target, _, _ = shared.SplitPatternQuery(target)
 
-	for i := 0; i < len(parts1); i++ {
-		p1, p2 := parts1[i], parts2[i]
+	targetSegments := rv.pathSegments(target)
 
-		// If both are static and different, no conflict
-		if !strings.HasPrefix(p1, "$") && !strings.HasPrefix(p2, "$") && p1 != p2 {
-			return false
+	var minSegments, maxSegments int
+	patternSegments := rv.pathSegments(pattern)
+	if base, _, optional, ok := shared.SplitCatchAllPattern(pattern); ok {
+		patternSegments = rv.pathSegments(base)
+		minSegments, maxSegments = len(patternSegments)+1, -1
+		if optional {
+			minSegments--
 		}
+	} else {
+		minSegments, maxSegments = len(patternSegments), len(patternSegments)
+	}
+
+	if len(targetSegments) < minSegments || (maxSegments >= 0 && len(targetSegments) > maxSegments) {
+		return false
 	}
 
+	for i, segment := range patternSegments {
+		if _, dynamic := shared.ParseRouteParamSegment(segment); dynamic {
+			continue
+		}
+		if _, dynamic := shared.ParseRouteParamSegment(targetSegments[i]); dynamic || targetSegments[i] != segment {
+			return false
+		}
+	}
 	return true
 }
+
+// pathSegments splits a path into its segments; the root path has none
+func (rv *routeValidator) pathSegments(path string) []string {
+	trimmed := strings.Trim(path, "/")
+	if trimmed == "" {
+		return nil
+	}
+	return strings.Split(trimmed, "/")
+}
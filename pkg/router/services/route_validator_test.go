@@ -0,0 +1,221 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestRouteValidatorCatchAllConflicts(t *testing.T) {
+	rv := &routeValidator{logger: zap.NewNop()}
+
+	tests := []struct {
+		name             string
+		routes           []string
+		expectedErrors   []string
+		expectedWarnings []string
+	}{
+		{
+			name:             "Catch-all shadows sibling route",
+			routes:           []string{"/docs/{slug...}", "/docs/intro"},
+			expectedWarnings: []string{"CATCH_ALL_SHADOWING"},
+		},
+		{
+			name:   "Catch-all and section root do not conflict",
+			routes: []string{"/docs/{slug...}", "/docs"},
+		},
+		{
+			name:           "Optional catch-all conflicts with section root",
+			routes:         []string{"/docs/{slug...?}", "/docs"},
+			expectedErrors: []string{"CATCH_ALL_CONFLICT"},
+		},
+		{
+			name:           "Two catch-alls on the same section",
+			routes:         []string{"/{locale}/docs/{slug...}", "/{locale}/docs/{path...?}"},
+			expectedErrors: []string{"AMBIGUOUS_CATCH_ALL"},
+		},
+		{
+			name:   "Unrelated sections",
+			routes: []string{"/docs/{slug...}", "/blog/{id}"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes := make([]interfaces.Route, len(tt.routes))
+			for i, path := range tt.routes {
+				routes[i] = interfaces.Route{Path: path, TemplateFile: "app" + path + "/page.templ"}
+			}
+
+			result := &ValidationResult{}
+			rv.ValidateRouteConflicts(routes, result)
+
+			var errorTypes, warningTypes []string
+			for _, e := range result.Errors {
+				errorTypes = append(errorTypes, e.Type)
+			}
+			for _, w := range result.Warnings {
+				warningTypes = append(warningTypes, w.Type)
+			}
+
+			assert.Equal(t, tt.expectedErrors, errorTypes)
+			assert.Equal(t, tt.expectedWarnings, warningTypes)
+		})
+	}
+}
+
+func TestRouteValidatorValidateRedirects(t *testing.T) {
+	rv := &routeValidator{logger: zap.NewNop()}
+
+	routes := []interfaces.Route{
+		{Path: "/{locale}/docs/{slug...?}", TemplateFile: "app/locale_/docs/slug____/page.templ"},
+		{Path: "/{locale}/user/{id}", TemplateFile: "app/locale_/user/id_/page.templ"},
+	}
+
+	tests := []struct {
+		name             string
+		rules            []interfaces.RedirectRule
+		expectedErrors   []string
+		expectedWarnings []string
+	}{
+		{
+			name: "Valid redirect to a page route",
+			rules: []interfaces.RedirectRule{
+				{From: "/old-docs/{slug...}", To: "/{locale}/docs/{slug...}", Status: 308},
+			},
+		},
+		{
+			name: "Missing target",
+			rules: []interfaces.RedirectRule{
+				{From: "/old"},
+			},
+			expectedErrors: []string{"INVALID_REDIRECT"},
+		},
+		{
+			name: "Unsupported status",
+			rules: []interfaces.RedirectRule{
+				{From: "/old", To: "/new", Status: 200},
+			},
+			expectedErrors: []string{"INVALID_REDIRECT_STATUS"},
+		},
+		{
+			name: "Self redirect",
+			rules: []interfaces.RedirectRule{
+				{From: "/loop/{id}", To: "/loop/{id}"},
+			},
+			expectedErrors: []string{"REDIRECT_LOOP"},
+		},
+		{
+			name: "Redirect chain that loops",
+			rules: []interfaces.RedirectRule{
+				{From: "/a", To: "/b"},
+				{From: "/b", To: "/c?ref=b"},
+				{From: "/c", To: "/a"},
+			},
+			expectedErrors: []string{"REDIRECT_LOOP"},
+		},
+		{
+			name: "Chain that ends at a page route",
+			rules: []interfaces.RedirectRule{
+				{From: "/a", To: "/b"},
+				{From: "/b", To: "/en/user/1"},
+			},
+		},
+		{
+			name: "External target never loops",
+			rules: []interfaces.RedirectRule{
+				{From: "/a", To: "https://example.com/a"},
+			},
+		},
+		{
+			name: "Rewrite without page route",
+			rules: []interfaces.RedirectRule{
+				{From: "/profile", To: "/about", Rewrite: true},
+			},
+			expectedErrors: []string{"REWRITE_TARGET_NOT_FOUND"},
+		},
+		{
+			name: "Rewrite to page route",
+			rules: []interfaces.RedirectRule{
+				{From: "/profile/{id}", To: "/en/user/{id}", Rewrite: true},
+			},
+		},
+		{
+			name: "Redirect source overlaps page route",
+			rules: []interfaces.RedirectRule{
+				{From: "/{lang}/user/{userId}", To: "/en/docs"},
+			},
+			expectedWarnings: []string{"REDIRECT_SHADOWS_ROUTE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &ValidationResult{}
+			rv.ValidateRedirects(tt.rules, routes, result)
+
+			var errorTypes, warningTypes []string
+			for _, e := range result.Errors {
+				errorTypes = append(errorTypes, e.Type)
+			}
+			for _, w := range result.Warnings {
+				warningTypes = append(warningTypes, w.Type)
+			}
+
+			assert.Equal(t, tt.expectedErrors, errorTypes)
+			assert.Equal(t, tt.expectedWarnings, warningTypes)
+		})
+	}
+}
+
+// layoutFileSystemChecker reports only the listed files as existing
+type layoutFileSystemChecker struct {
+	mockFileSystemChecker
+	files map[string]bool
+}
+
+func (m *layoutFileSystemChecker) FileExists(path string) bool { return m.files[path] }
+
+func TestRouteValidatorValidateLayoutSettings(t *testing.T) {
+	rv := &routeValidator{
+		logger: zap.NewNop(),
+		config: &mockConfigService{},
+		fileSystem: &layoutFileSystemChecker{files: map[string]bool{
+			"app/layout.templ":       true,
+			"app/print/layout.templ": true,
+		}},
+	}
+
+	tests := []struct {
+		name           string
+		layoutSettings interface{}
+		expectedErrors []string
+	}{
+		{name: "Layout opt-out", layoutSettings: "none"},
+		{name: "Existing named layout", layoutSettings: "print"},
+		{name: "Existing layout file path", layoutSettings: "print/layout.templ"},
+		{name: "Root layout", layoutSettings: "/"},
+		{name: "Named layout in map", layoutSettings: map[string]interface{}{"name": "print", "inherit": false}},
+		{name: "Missing named layout", layoutSettings: "embed", expectedErrors: []string{"LAYOUT_NOT_FOUND"}},
+		{name: "Layout outside the layout root", layoutSettings: "../shared", expectedErrors: []string{"LAYOUT_NOT_FOUND"}},
+		{name: "Invalid layout setting", layoutSettings: 42, expectedErrors: []string{"INVALID_LAYOUT_SETTINGS"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := &interfaces.Route{Path: "/report", TemplateFile: "app/report/page.templ"}
+			config := &interfaces.ConfigFile{FilePath: "app/report/page.templ.yaml", LayoutSettings: tt.layoutSettings}
+
+			result := &ValidationResult{}
+			rv.ValidateRouteConfig(route, config, result)
+
+			var errorTypes []string
+			for _, e := range result.Errors {
+				errorTypes = append(errorTypes, e.Type)
+			}
+			assert.Equal(t, tt.expectedErrors, errorTypes)
+		})
+	}
+}
@@ -54,10 +54,7 @@ func (vo *validationOrchestrator) ValidateConfiguration(routes []interfaces.Rout
 		zap.Int("routes", len(routes)),
 		zap.Int("configs", len(configs)))
 
-	result := vo.validateAll(routes, configs)
-
-	// Log validation results
-	vo.logValidationResults(result)
+	result := vo.Validate(routes, configs)
 
 	// Return error if there are validation errors
 	if result.HasErrors() {
@@ -71,6 +68,29 @@ func (vo *validationOrchestrator) ValidateConfiguration(routes []interfaces.Rout
 	return nil
 }
 
+// Validate runs all validators and logs the result (implements interfaces.ValidationService)
+func (vo *validationOrchestrator) Validate(routes []interfaces.Route, configs map[string]*interfaces.ConfigFile) *interfaces.ValidationResult {
+	result := vo.validateAll(routes, configs)
+
+	// Log validation results
+	vo.logValidationResults(result)
+
+	return result
+}
+
+// ValidateRedirects validates redirect and rewrite rules against the discovered routes (implements interfaces.ValidationService)
+func (vo *validationOrchestrator) ValidateRedirects(rules []interfaces.RedirectRule, routes []interfaces.Route) *interfaces.ValidationResult {
+	result := &ValidationResult{
+		Errors:   make([]ValidationError, 0),
+		Warnings: make([]ValidationWarning, 0),
+	}
+
+	vo.routeValidator.ValidateRedirects(rules, routes, result)
+	vo.logValidationResults(result)
+
+	return result
+}
+
 // validateAll performs comprehensive validation using specialized validators
 func (vo *validationOrchestrator) validateAll(routes []interfaces.Route, configs map[string]*interfaces.ConfigFile) *ValidationResult {
 	result := &ValidationResult{
@@ -10,7 +10,6 @@ import (
 	"github.com/denkhaus/templ-router/pkg/interfaces"
 	"github.com/denkhaus/templ-router/pkg/router/middleware"
 	"github.com/denkhaus/templ-router/pkg/router/pipeline"
-	"github.com/go-chi/chi/v5"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -48,6 +47,10 @@ func (m *MockConfigService) IsProduction() bool                        { return
 func (m *MockConfigService) GetRouterEnableTrailingSlash() bool     { return true }
 func (m *MockConfigService) GetRouterEnableSlashRedirect() bool     { return true }
 func (m *MockConfigService) GetRouterEnableMethodNotAllowed() bool  { return true }
+func (m *MockConfigService) GetRouterParameterValidationStatus() int { return 404 }
+func (m *MockConfigService) GetRouterValidationMode() string { return "warn" }
+func (m *MockConfigService) GetRouterBasePath() string { return "" }
+func (m *MockConfigService) GetRouterRenderMode() string { return "direct" }
 func (m *MockConfigService) GetServerReadTimeout() time.Duration       { return 30 * time.Second }
 func (m *MockConfigService) GetServerWriteTimeout() time.Duration      { return 30 * time.Second }
 func (m *MockConfigService) GetServerIdleTimeout() time.Duration       { return 60 * time.Second }
@@ -62,6 +65,7 @@ func (m *MockConfigService) IsEmailVerificationRequired() bool         { return
 func (m *MockConfigService) GetVerificationTokenExpiry() time.Duration { return 24 * time.Hour }
 func (m *MockConfigService) GetSessionCookieName() string              { return "session" }
 func (m *MockConfigService) GetSessionExpiry() time.Duration           { return 24 * time.Hour }
+func (m *MockConfigService) GetSessionRememberExpiry() time.Duration   { return 30 * 24 * time.Hour }
 func (m *MockConfigService) IsSessionSecure() bool                     { return false }
 func (m *MockConfigService) IsSessionHttpOnly() bool                   { return true }
 func (m *MockConfigService) GetSessionSameSite() string                { return "Lax" }
@@ -72,15 +76,20 @@ func (m *MockConfigService) GetDefaultAdminEmail() string              { return
 func (m *MockConfigService) GetDefaultAdminPassword() string           { return "" }
 func (m *MockConfigService) GetDefaultAdminFirstName() string          { return "" }
 func (m *MockConfigService) GetDefaultAdminLastName() string           { return "" }
+func (m *MockConfigService) IsCSRFEnabled() bool                       { return false }
 func (m *MockConfigService) GetCSRFSecret() string                     { return "secret" }
 func (m *MockConfigService) IsCSRFSecure() bool                        { return false }
 func (m *MockConfigService) IsCSRFHttpOnly() bool                      { return true }
 func (m *MockConfigService) GetCSRFSameSite() string                   { return "Lax" }
+func (m *MockConfigService) GetCSRFExemptPaths() []string              { return nil }
 func (m *MockConfigService) IsRateLimitEnabled() bool                  { return false }
 func (m *MockConfigService) GetRateLimitRequests() int                 { return 100 }
+func (m *MockConfigService) IsRateLimitAllRoutesEnabled() bool         { return false }
+func (m *MockConfigService) GetTrustedProxies() []string               { return nil }
 func (m *MockConfigService) AreSecurityHeadersEnabled() bool           { return false }
 func (m *MockConfigService) IsHSTSEnabled() bool                       { return false }
 func (m *MockConfigService) GetHSTSMaxAge() int                        { return 31536000 }
+func (m *MockConfigService) GetContentSecurityPolicy() string          { return "" }
 func (m *MockConfigService) GetLogLevel() string                       { return "info" }
 func (m *MockConfigService) GetLogFormat() string                      { return "json" }
 func (m *MockConfigService) GetLogOutput() string                      { return "stdout" }
@@ -98,8 +107,7 @@ func (m *MockConfigService) IsEmailDummyModeEnabled() bool             { return
 
 type MockAssetsService struct{}
 
-func (m *MockAssetsService) SetupRoutesWithRouter(mux chi.Router) {}
-func (m *MockAssetsService) SetupRoutes(mux *chi.Mux)             {}
+func (m *MockAssetsService) SetupRoutes(router interfaces.RouterAdapter) error { return nil }
 
 type MockRouteDiscovery struct {
 	Routes         []interfaces.Route
@@ -133,6 +141,7 @@ type MockConfigLoader struct {
 	ShouldError  bool
 	Config       *interfaces.ConfigFile
 	AuthSettings *interfaces.AuthSettings
+	Redirects    []interfaces.RedirectRule
 }
 
 func (m *MockConfigLoader) LoadRouteConfig(templateFile string) (*interfaces.ConfigFile, error) {
@@ -165,6 +174,13 @@ func (m *MockConfigLoader) LoadAuthSettings(templatePath string) (*interfaces.Au
 	return &interfaces.AuthSettings{Type: interfaces.AuthTypePublic}, nil
 }
 
+func (m *MockConfigLoader) LoadRedirects(rootDir string) ([]interfaces.RedirectRule, error) {
+	if m.ShouldError {
+		return nil, errors.New("mock redirects load error")
+	}
+	return m.Redirects, nil
+}
+
 type MockHandlerPipeline struct {
 	ShouldError bool
 }
@@ -217,6 +233,10 @@ func (m *MockTemplateService) RenderComponent(route interfaces.Route, routerCtx
 	return templ.Raw("test content"), nil
 }
 
+func (m *MockTemplateService) RenderFragment(route interfaces.Route, fragment string, routerCtx interfaces.RouterContext, ctx context.Context) (templ.Component, error) {
+	return templ.Raw("fragment content"), nil
+}
+
 func (m *MockTemplateService) RenderLayoutComponent(layoutPath string, content templ.Component, ctx context.Context) (templ.Component, error) {
 	return templ.Raw("layout content"), nil
 }
@@ -227,6 +247,10 @@ func (m *MockLayoutService) FindLayoutForTemplate(templatePath string) *interfac
 	return &interfaces.LayoutTemplate{FilePath: "/app/layout.templ"}
 }
 
+func (m *MockLayoutService) FindNamedLayout(name string) *interfaces.LayoutTemplate {
+	return &interfaces.LayoutTemplate{FilePath: "/app/" + name + "/layout.templ"}
+}
+
 func (m *MockLayoutService) WrapInLayout(component templ.Component, layout *interfaces.LayoutTemplate, ctx context.Context) templ.Component {
 	return templ.Raw("wrapped content")
 }
@@ -241,6 +265,10 @@ func (m *MockErrorService) CreateErrorComponent(message, path string) templ.Comp
 	return templ.Raw("error content")
 }
 
+func (m *MockErrorService) CreateErrorInfoComponent(info *interfaces.ErrorInfo, lookupPath string) templ.Component {
+	return templ.Raw("error content")
+}
+
 // Middleware mocks
 type MockAuthMiddleware struct{}
 
@@ -269,9 +297,9 @@ func (m *MockTemplateMiddleware) Handle(route interfaces.Route, params map[strin
 
 type MockRouterMiddleware struct{}
 
-func (m *MockRouterMiddleware) ConfigureRouterMiddleware(chiRouter *chi.Mux) error {
+func (m *MockRouterMiddleware) ConfigureRouterMiddleware(next http.Handler) (http.Handler, error) {
 	// Mock implementation - do nothing
-	return nil
+	return next, nil
 }
 
 // Helper function to create a complete test DI container
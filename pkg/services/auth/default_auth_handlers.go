@@ -3,20 +3,33 @@ package auth
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
 	"github.com/denkhaus/templ-router/pkg/router/i18n"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
 
+// Sign in attempts are limited more strictly than other requests to slow down credential stuffing
+const (
+	signInRateLimitRequests = 5
+	signInRateLimitPeriod   = time.Minute
+)
+
+// rememberMeFieldName is the sign in form field selecting the longer session expiry
+const rememberMeFieldName = "remember-me"
+
 // authHandlersImpl provides generic authentication API handlers
 // Works with any UserEntity implementation through the UserStore interface
 type authHandlersImpl struct {
 	userStore     interfaces.UserStore
 	sessionStore  interfaces.SessionStore
-	configService interfaces.ConfigService
-	logger        *zap.Logger
+	rateLimiter    interfaces.RateLimiter
+	trustedProxies shared.TrustedProxies
+	configService  interfaces.ConfigService
+	logger         *zap.Logger
 }
 
 // NewAuthHandlers creates new generic auth handlers
@@ -24,13 +37,21 @@ func NewAuthHandlers(i do.Injector) (interfaces.AuthHandlers, error) {
 	userStore := do.MustInvoke[interfaces.UserStore](i)
 	configService := do.MustInvoke[interfaces.ConfigService](i)
 	sessionStore := do.MustInvoke[interfaces.SessionStore](i)
+	rateLimiter := do.MustInvoke[interfaces.RateLimiter](i)
 	logger := do.MustInvoke[*zap.Logger](i)
 
+	trustedProxies, err := shared.ParseTrustedProxies(configService.GetTrustedProxies())
+	if err != nil {
+		return nil, err
+	}
+
 	return &authHandlersImpl{
-		userStore:     userStore,
-		configService: configService,
-		sessionStore:  sessionStore,
-		logger:        logger,
+		userStore:      userStore,
+		configService:  configService,
+		sessionStore:   sessionStore,
+		rateLimiter:    rateLimiter,
+		trustedProxies: trustedProxies,
+		logger:         logger,
 	}, nil
 }
 
@@ -49,6 +70,20 @@ func (h *authHandlersImpl) HandleSignIn(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if h.configService.IsRateLimitEnabled() {
+		clientIP := h.trustedProxies.ClientIP(r)
+		allowed, retryAfter := h.rateLimiter.Allow("signin|ip:"+clientIP, signInRateLimitRequests, signInRateLimitPeriod)
+		if !allowed {
+			h.logger.Warn("Sign in rate limit exceeded",
+				zap.String("client_ip", clientIP),
+				zap.Duration("retry_after", retryAfter))
+
+			shared.SetRetryAfter(w, retryAfter)
+			h.respondWithError(w, r, interfaces.DefaultErrorMessage(interfaces.ErrorMessageKeyTooManyRequests), http.StatusTooManyRequests)
+			return
+		}
+	}
+
 	// UserStore handles complete data extraction and validation from request
 	user, err := h.userStore.ValidateCredentialsFromRequest(r)
 	if err != nil {
@@ -59,22 +94,14 @@ func (h *authHandlersImpl) HandleSignIn(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Create session
-	session, err := h.sessionStore.CreateSession(user.GetID())
+	session, err := h.signInSession(r, user.GetID(), isRememberMe(r))
 	if err != nil {
 		h.logger.Error("Failed to create session", zap.Error(err))
 		h.respondWithError(w, r, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
-	// Set session cookie
-	http.SetCookie(w, &http.Cookie{
-		Name:     h.configService.GetSessionCookieName(),
-		Value:    session.ID,
-		Path:     "/",
-		HttpOnly: true,
-		SameSite: http.SameSiteLaxMode,
-	})
+	h.sessionStore.WriteSessionCookie(w, session)
 
 	h.logger.Info("User logged in successfully",
 		zap.String("user_id", user.GetID()),
@@ -83,12 +110,12 @@ func (h *authHandlersImpl) HandleSignIn(w http.ResponseWriter, r *http.Request)
 	// Redirect to success route on successful login
 	successRoute := h.configService.GetSignInSuccessRoute()
 	if successRoute != "" {
-		successRoute := i18n.LocalizeRouteIfRequired(r.Context(), successRoute)
+		successRoute := shared.JoinBasePath(shared.GetBasePath(r.Context()), i18n.LocalizeRouteIfRequired(r.Context(), successRoute))
 		
 		// Check if this is an HTMX request
 		if h.isHTMXRequest(r) {
 			// Use HX-Redirect header for HTMX requests
-			w.Header().Set("HX-Redirect", successRoute)
+			w.Header().Set(shared.HXRedirectHeader, successRoute)
 			w.WriteHeader(http.StatusOK)
 			return
 		}
@@ -130,12 +157,12 @@ func (h *authHandlersImpl) HandleSignUp(w http.ResponseWriter, r *http.Request)
 	// Redirect to success route on successful signup
 	successRoute := h.configService.GetSignUpSuccessRoute()
 	if successRoute != "" {
-		successRoute := i18n.LocalizeRouteIfRequired(r.Context(), successRoute)
+		successRoute := shared.JoinBasePath(shared.GetBasePath(r.Context()), i18n.LocalizeRouteIfRequired(r.Context(), successRoute))
 		
 		// Check if this is an HTMX request
 		if h.isHTMXRequest(r) {
 			// Use HX-Redirect header for HTMX requests
-			w.Header().Set("HX-Redirect", successRoute)
+			w.Header().Set(shared.HXRedirectHeader, successRoute)
 			w.WriteHeader(http.StatusOK)
 			return
 		}
@@ -159,30 +186,21 @@ func (h *authHandlersImpl) HandleSignOut(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	sessionCookieName := h.configService.GetSessionCookieName()
-
 	// Get session cookie
-	cookie, err := r.Cookie(sessionCookieName)
+	cookie, err := r.Cookie(h.configService.GetSessionCookieName())
 	if err == nil {
 		// Delete session
 		h.sessionStore.DeleteSession(cookie.Value)
 	}
 
-	// Clear session cookie
-	http.SetCookie(w, &http.Cookie{
-		Name:     sessionCookieName,
-		Value:    "",
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   -1,
-	})
+	h.sessionStore.ClearSessionCookie(w)
 
 	h.logger.Info("User logged out successfully")
 
 	// Redirect to success route on successful logout
 	successRoute := h.configService.GetSignOutSuccessRoute()
 	if successRoute != "" {
-		successRoute := i18n.LocalizeRouteIfRequired(r.Context(), successRoute)
+		successRoute := shared.JoinBasePath(shared.GetBasePath(r.Context()), i18n.LocalizeRouteIfRequired(r.Context(), successRoute))
 		http.Redirect(w, r, successRoute, http.StatusSeeOther)
 		return
 	}
@@ -213,9 +231,34 @@ func (h *authHandlersImpl) respondWithError(w http.ResponseWriter, r *http.Reque
 	})
 }
 
+// signInSession returns a session under a new ID for the signed in user. The ID of the session
+// the client brought along may have been planted (session fixation), so it is never reused:
+// a session of the same user with the same "remember me" choice is rotated, any other is replaced.
+func (h *authHandlersImpl) signInSession(r *http.Request, userID string, remember bool) (*interfaces.Session, error) {
+	if existing, err := h.sessionStore.GetSession(r); err == nil {
+		if existing.UserID == userID && existing.Remember == remember {
+			if session, err := h.sessionStore.RotateSession(existing.ID); err == nil {
+				return session, nil
+			}
+		}
+		h.sessionStore.DeleteSession(existing.ID)
+	}
+
+	return h.sessionStore.CreateSession(userID, remember)
+}
+
+// isRememberMe checks if the sign in form asked for the longer "remember me" session
+func isRememberMe(r *http.Request) bool {
+	switch r.PostFormValue(rememberMeFieldName) {
+	case "on", "true", "1":
+		return true
+	}
+	return false
+}
+
 // isHTMXRequest checks if the request is from HTMX
 func (h *authHandlersImpl) isHTMXRequest(r *http.Request) bool {
-	return r.Header.Get("HX-Request") == "true"
+	return shared.IsHTMXRequest(r)
 }
 
 // respondWithSuccess sends a success JSON response
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// handlerTestConfigService answers sign in with JSON and without rate limit
+type handlerTestConfigService struct {
+	sessionTestConfigService
+}
+
+func (m *handlerTestConfigService) IsRateLimitEnabled() bool      { return false }
+func (m *handlerTestConfigService) GetSignInSuccessRoute() string { return "" }
+
+// handlerTestUser is the user of handlerTestUserStore
+type handlerTestUser struct{}
+
+func (u *handlerTestUser) GetID() string      { return "user-1" }
+func (u *handlerTestUser) GetEmail() string   { return "jane@example.com" }
+func (u *handlerTestUser) GetRoles() []string { return []string{"user"} }
+
+// handlerTestUserStore signs in every request as user-1
+type handlerTestUserStore struct {
+	interfaces.UserStore
+}
+
+func (s *handlerTestUserStore) ValidateCredentialsFromRequest(req *http.Request) (interfaces.UserEntity, error) {
+	return &handlerTestUser{}, nil
+}
+
+// signIn posts the sign in form with the session cookie sessionID and returns the new session ID
+func signIn(t *testing.T, h *authHandlersImpl, sessionID string, remember bool) string {
+	form := url.Values{"email": {"jane@example.com"}, "password": {"secret"}}
+	if remember {
+		form.Set(rememberMeFieldName, "on")
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/api/auth/signin", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if sessionID != "" {
+		r.AddCookie(&http.Cookie{Name: "session_id", Value: sessionID})
+	}
+
+	rec := httptest.NewRecorder()
+	h.HandleSignIn(rec, r)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	return cookies[0].Value
+}
+
+func TestHandleSignInNeverReusesSessionID(t *testing.T) {
+	store, _ := newTestSessionStore()
+	h := &authHandlersImpl{
+		userStore:     &handlerTestUserStore{},
+		sessionStore:  store,
+		configService: &handlerTestConfigService{},
+		logger:        zap.NewNop(),
+	}
+
+	t.Run("session of the same user is rotated", func(t *testing.T) {
+		existing, err := store.CreateSession("user-1", false)
+		require.NoError(t, err)
+
+		sessionID := signIn(t, h, existing.ID, false)
+		assert.NotEqual(t, existing.ID, sessionID)
+
+		_, err = store.GetSession(sessionRequest(existing.ID))
+		assert.Error(t, err, "the old ID must be invalid")
+		rotated, err := store.GetSession(sessionRequest(sessionID))
+		require.NoError(t, err)
+		assert.Equal(t, existing.CreatedAt, rotated.CreatedAt)
+	})
+
+	t.Run("session of another user is replaced", func(t *testing.T) {
+		planted, err := store.CreateSession("attacker", false)
+		require.NoError(t, err)
+
+		sessionID := signIn(t, h, planted.ID, false)
+		assert.NotEqual(t, planted.ID, sessionID)
+
+		_, err = store.GetSession(sessionRequest(planted.ID))
+		assert.Error(t, err)
+		session, err := store.GetSession(sessionRequest(sessionID))
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", session.UserID)
+	})
+
+	t.Run("remember me creates a new session", func(t *testing.T) {
+		existing, err := store.CreateSession("user-1", false)
+		require.NoError(t, err)
+
+		sessionID := signIn(t, h, existing.ID, true)
+		session, err := store.GetSession(sessionRequest(sessionID))
+		require.NoError(t, err)
+		assert.True(t, session.Remember)
+		_, err = store.GetSession(sessionRequest(existing.ID))
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown session ID is not adopted", func(t *testing.T) {
+		sessionID := signIn(t, h, "planted", false)
+		assert.NotEqual(t, "planted", sessionID)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
 	"github.com/samber/do/v2"
 	"go.uber.org/zap"
 )
@@ -77,17 +78,24 @@ func (s *DefaultAuthService) authenticateUser(req *http.Request, requirements *i
 			User:            user,
 			RedirectURL:     "/unauthorized",
 			ErrorMessage:    "Insufficient permissions",
+			Session:         session,
 		}, nil
 	}
 
 	return &interfaces.AuthResult{
 		IsAuthenticated: true,
 		User:            user,
+		Session:         session,
 	}, nil
 }
 
-// HasRequiredPermissions checks if the user has the required permissions
+// HasRequiredPermissions checks if the user has the required permissions.
+// The user authenticated by the auth middleware is read from the request context.
 func (s *DefaultAuthService) HasRequiredPermissions(req *http.Request, settings *interfaces.AuthSettings) bool {
+	if user, ok := req.Context().Value(shared.UserContextKey).(interfaces.UserEntity); ok {
+		return settings == nil || settings.Type == interfaces.AuthTypePublic || s.hasRequiredRoles(user, settings.Roles)
+	}
+
 	result, err := s.Authenticate(req, settings)
 	if err != nil || !result.IsAuthenticated {
 		return false
@@ -121,7 +129,7 @@ func (s *DefaultAuthService) Login(email, password string) (interfaces.UserEntit
 	}
 
 	// Create session
-	session, err := s.sessionStore.CreateSession(user.GetID())
+	session, err := s.sessionStore.CreateSession(user.GetID(), false)
 	if err != nil {
 		return nil, "", err
 	}
@@ -20,8 +20,8 @@ type inMemmorySessionStoreImpl struct {
 	sessions      map[string]*interfaces.Session
 	mutex         sync.RWMutex
 	configService interfaces.ConfigService
-	sessionExpiry time.Duration
 	cookieName    string
+	now           func() time.Time
 }
 
 // NewInMemorySessionStore creates a new default session store for DI
@@ -35,7 +35,7 @@ func NewInMemorySessionStore(i do.Injector) (interfaces.SessionStore, error) {
 		mutex:         sync.RWMutex{},
 		configService: configService,
 		cookieName:    configService.GetSessionCookieName(),
-		sessionExpiry: configService.GetSessionExpiry(),
+		now:           time.Now,
 	}
 
 	// Start cleanup routine for expired sessions
@@ -44,7 +44,8 @@ func NewInMemorySessionStore(i do.Injector) (interfaces.SessionStore, error) {
 	return store, nil
 }
 
-// GetSession retrieves a session from the request
+// GetSession retrieves a session from the request. Once less than half of its expiry is left,
+// the expiry slides to the full duration again and the returned session is marked Renewed.
 func (s *inMemmorySessionStoreImpl) GetSession(req *http.Request) (*interfaces.Session, error) {
 	// Get session ID from cookie
 	cookie, err := req.Cookie(s.cookieName)
@@ -52,37 +53,45 @@ func (s *inMemmorySessionStoreImpl) GetSession(req *http.Request) (*interfaces.S
 		return nil, fmt.Errorf("no session cookie found")
 	}
 
-	s.mutex.RLock()
-	session, exists := s.sessions[cookie.Value]
-	s.mutex.RUnlock()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 
+	session, exists := s.sessions[cookie.Value]
 	if !exists {
 		return nil, fmt.Errorf("session not found")
 	}
 
 	// Check if session is expired
-	if time.Now().After(session.ExpiresAt) {
-		s.DeleteSession(session.ID)
+	now := s.now()
+	if now.After(session.ExpiresAt) {
+		delete(s.sessions, session.ID)
 		return nil, fmt.Errorf("session expired")
 	}
 
-	return session, nil
+	// Sessions are returned as copies, the stored ones are only changed under the lock
+	result := *session
+	if RenewSession(&result, SessionExpiry(s.configService, session.Remember), now) {
+		session.ExpiresAt = result.ExpiresAt
+	}
+
+	return &result, nil
 }
 
 // CreateSession creates a new session for a user
-func (s *inMemmorySessionStoreImpl) CreateSession(userID string) (*interfaces.Session, error) {
+func (s *inMemmorySessionStoreImpl) CreateSession(userID string, remember bool) (*interfaces.Session, error) {
 	sessionID, err := s.generateSessionID()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate session ID: %w", err)
 	}
 
-	now := time.Now()
+	now := s.now()
 	session := &interfaces.Session{
 		ID:        sessionID,
 		UserID:    userID,
 		Valid:     true,
 		CreatedAt: now,
-		ExpiresAt: now.Add(s.sessionExpiry),
+		ExpiresAt: now.Add(SessionExpiry(s.configService, remember)),
+		Remember:  remember,
 	}
 
 	s.mutex.Lock()
@@ -90,10 +99,49 @@ func (s *inMemmorySessionStoreImpl) CreateSession(userID string) (*interfaces.Se
 	s.mutex.Unlock()
 
 	s.logger.Info("Session created",
-		zap.String(s.cookieName, sessionID),
-		zap.String("user_id", userID))
+		zap.String("user_id", userID),
+		zap.Bool("remember", remember))
+
+	result := *session
+	return &result, nil
+}
+
+// RotateSession moves a session to a new ID with a fresh expiry, the old ID becomes invalid
+func (s *inMemmorySessionStoreImpl) RotateSession(sessionID string) (*interfaces.Session, error) {
+	newID, err := s.generateSessionID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate session ID: %w", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	session, exists := s.sessions[sessionID]
+	if !exists || s.now().After(session.ExpiresAt) {
+		delete(s.sessions, sessionID)
+		return nil, fmt.Errorf("session not found")
+	}
+
+	rotated := *session
+	rotated.ID = newID
+	rotated.ExpiresAt = s.now().Add(SessionExpiry(s.configService, session.Remember))
+	delete(s.sessions, sessionID)
+	s.sessions[newID] = &rotated
+
+	s.logger.Info("Session rotated", zap.String("user_id", session.UserID))
+
+	result := rotated
+	return &result, nil
+}
+
+// WriteSessionCookie sets the session cookie, it expires together with the session
+func (s *inMemmorySessionStoreImpl) WriteSessionCookie(w http.ResponseWriter, session *interfaces.Session) {
+	WriteSessionCookie(w, s.configService, session, s.now())
+}
 
-	return session, nil
+// ClearSessionCookie removes the session cookie
+func (s *inMemmorySessionStoreImpl) ClearSessionCookie(w http.ResponseWriter) {
+	ClearSessionCookie(w, s.configService)
 }
 
 // DeleteSession deletes a session
@@ -102,7 +150,7 @@ func (s *inMemmorySessionStoreImpl) DeleteSession(sessionID string) error {
 	delete(s.sessions, sessionID)
 	s.mutex.Unlock()
 
-	s.logger.Info("Session deleted", zap.String(s.cookieName, sessionID))
+	s.logger.Info("Session deleted")
 	return nil
 }
 
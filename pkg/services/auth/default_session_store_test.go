@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// sessionTestConfigService provides the session policy, other methods are not used
+type sessionTestConfigService struct {
+	interfaces.ConfigService
+}
+
+func (m *sessionTestConfigService) GetSessionCookieName() string    { return "session_id" }
+func (m *sessionTestConfigService) GetSessionExpiry() time.Duration { return time.Hour }
+func (m *sessionTestConfigService) GetSessionRememberExpiry() time.Duration {
+	return 30 * 24 * time.Hour
+}
+func (m *sessionTestConfigService) IsSessionSecure() bool      { return true }
+func (m *sessionTestConfigService) IsSessionHttpOnly() bool    { return true }
+func (m *sessionTestConfigService) GetSessionSameSite() string { return "strict" }
+
+// testClock is a manually advanced clock
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func newTestSessionStore() (*inMemmorySessionStoreImpl, *testClock) {
+	clock := &testClock{now: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+	return &inMemmorySessionStoreImpl{
+		logger:        zap.NewNop(),
+		sessions:      make(map[string]*interfaces.Session),
+		configService: &sessionTestConfigService{},
+		cookieName:    "session_id",
+		now:           clock.Now,
+	}, clock
+}
+
+func sessionRequest(sessionID string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.AddCookie(&http.Cookie{Name: "session_id", Value: sessionID})
+	return r
+}
+
+func TestSessionStoreSlidingExpiry(t *testing.T) {
+	store, clock := newTestSessionStore()
+	created, err := store.CreateSession("user-1", false)
+	require.NoError(t, err)
+	assert.Equal(t, clock.now.Add(time.Hour), created.ExpiresAt)
+
+	// More than half of the expiry is left, nothing changes
+	clock.now = clock.now.Add(20 * time.Minute)
+	session, err := store.GetSession(sessionRequest(created.ID))
+	require.NoError(t, err)
+	assert.False(t, session.Renewed)
+	assert.Equal(t, created.ExpiresAt, session.ExpiresAt)
+
+	// Less than half is left, the expiry slides
+	clock.now = clock.now.Add(20 * time.Minute)
+	session, err = store.GetSession(sessionRequest(created.ID))
+	require.NoError(t, err)
+	assert.True(t, session.Renewed)
+	assert.Equal(t, clock.now.Add(time.Hour), session.ExpiresAt)
+
+	// An idle session expires
+	clock.now = clock.now.Add(time.Hour + time.Second)
+	_, err = store.GetSession(sessionRequest(created.ID))
+	assert.Error(t, err)
+	assert.Empty(t, store.sessions)
+}
+
+func TestSessionStoreRememberExpiry(t *testing.T) {
+	store, clock := newTestSessionStore()
+	session, err := store.CreateSession("user-1", true)
+	require.NoError(t, err)
+
+	assert.True(t, session.Remember)
+	assert.Equal(t, clock.now.Add(30*24*time.Hour), session.ExpiresAt)
+}
+
+func TestSessionStoreRotateSession(t *testing.T) {
+	store, clock := newTestSessionStore()
+	created, err := store.CreateSession("user-1", true)
+	require.NoError(t, err)
+
+	clock.now = clock.now.Add(time.Hour)
+	rotated, err := store.RotateSession(created.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, created.ID, rotated.ID)
+	assert.Equal(t, "user-1", rotated.UserID)
+	assert.True(t, rotated.Remember)
+	assert.Equal(t, clock.now.Add(30*24*time.Hour), rotated.ExpiresAt)
+
+	// The old ID is invalid, the new one works
+	_, err = store.GetSession(sessionRequest(created.ID))
+	assert.Error(t, err)
+	_, err = store.GetSession(sessionRequest(rotated.ID))
+	assert.NoError(t, err)
+
+	_, err = store.RotateSession(created.ID)
+	assert.Error(t, err)
+}
+
+func TestSessionStoreSessionCookie(t *testing.T) {
+	store, clock := newTestSessionStore()
+	session, err := store.CreateSession("user-1", false)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	store.WriteSessionCookie(rec, session)
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+
+	cookie := cookies[0]
+	assert.Equal(t, "session_id", cookie.Name)
+	assert.Equal(t, session.ID, cookie.Value)
+	assert.Equal(t, "/", cookie.Path)
+	assert.Equal(t, 3600, cookie.MaxAge)
+	assert.True(t, cookie.Expires.Equal(clock.now.Add(time.Hour)))
+	assert.True(t, cookie.Secure)
+	assert.True(t, cookie.HttpOnly)
+	assert.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+
+	rec = httptest.NewRecorder()
+	store.ClearSessionCookie(rec)
+	cookies = rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, -1, cookies[0].MaxAge)
+	assert.True(t, cookies[0].Secure)
+	assert.Equal(t, http.SameSiteStrictMode, cookies[0].SameSite)
+}
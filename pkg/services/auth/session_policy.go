@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/shared"
+)
+
+// The session policy is shared by all SessionStore implementations, so sessions behave the
+// same no matter where they are stored
+
+// SessionExpiry returns the configured session duration, remember selects the "remember me" expiry
+func SessionExpiry(configService interfaces.ConfigService, remember bool) time.Duration {
+	if remember {
+		return configService.GetSessionRememberExpiry()
+	}
+	return configService.GetSessionExpiry()
+}
+
+// RenewSession slides the expiry of session to now+expiry once less than half of it is left
+// and marks the session Renewed. It reports whether the session was renewed.
+func RenewSession(session *interfaces.Session, expiry time.Duration, now time.Time) bool {
+	if session.ExpiresAt.Sub(now) >= expiry/2 {
+		return false
+	}
+	session.ExpiresAt = now.Add(expiry)
+	session.Renewed = true
+	return true
+}
+
+// WriteSessionCookie sets the session cookie with the configured attributes, it expires together with the session
+func WriteSessionCookie(w http.ResponseWriter, configService interfaces.ConfigService, session *interfaces.Session, now time.Time) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     configService.GetSessionCookieName(),
+		Value:    session.ID,
+		Path:     "/",
+		Expires:  session.ExpiresAt,
+		MaxAge:   max(int(session.ExpiresAt.Sub(now)/time.Second), 1),
+		Secure:   configService.IsSessionSecure(),
+		HttpOnly: configService.IsSessionHttpOnly(),
+		SameSite: shared.ParseSameSite(configService.GetSessionSameSite()),
+	})
+}
+
+// ClearSessionCookie removes the session cookie with the attributes it was set with
+func ClearSessionCookie(w http.ResponseWriter, configService interfaces.ConfigService) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     configService.GetSessionCookieName(),
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   configService.IsSessionSecure(),
+		HttpOnly: configService.IsSessionHttpOnly(),
+		SameSite: shared.ParseSameSite(configService.GetSessionSameSite()),
+	})
+}
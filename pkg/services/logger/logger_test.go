@@ -36,6 +36,10 @@ func (m *mockLoggerConfigService) IsProduction() bool                     { retu
 func (m *mockLoggerConfigService) GetRouterEnableTrailingSlash() bool     { return true }
 func (m *mockLoggerConfigService) GetRouterEnableSlashRedirect() bool     { return true }
 func (m *mockLoggerConfigService) GetRouterEnableMethodNotAllowed() bool  { return true }
+func (m *mockLoggerConfigService) GetRouterParameterValidationStatus() int { return 404 }
+func (m *mockLoggerConfigService) GetRouterValidationMode() string { return "warn" }
+func (m *mockLoggerConfigService) GetRouterBasePath() string { return "" }
+func (m *mockLoggerConfigService) GetRouterRenderMode() string { return "direct" }
 
 // Implement remaining interface methods with defaults
 func (m *mockLoggerConfigService) GetServerHost() string                     { return "localhost" }
@@ -67,6 +71,7 @@ func (m *mockLoggerConfigService) IsEmailVerificationRequired() bool         { r
 func (m *mockLoggerConfigService) GetVerificationTokenExpiry() time.Duration { return 24 * time.Hour }
 func (m *mockLoggerConfigService) GetSessionCookieName() string              { return "session" }
 func (m *mockLoggerConfigService) GetSessionExpiry() time.Duration           { return 24 * time.Hour }
+func (m *mockLoggerConfigService) GetSessionRememberExpiry() time.Duration   { return 30 * 24 * time.Hour }
 func (m *mockLoggerConfigService) IsSessionSecure() bool                     { return false }
 func (m *mockLoggerConfigService) IsSessionHttpOnly() bool                   { return true }
 func (m *mockLoggerConfigService) GetSessionSameSite() string                { return "Lax" }
@@ -77,15 +82,20 @@ func (m *mockLoggerConfigService) GetDefaultAdminEmail() string              { r
 func (m *mockLoggerConfigService) GetDefaultAdminPassword() string           { return "" }
 func (m *mockLoggerConfigService) GetDefaultAdminFirstName() string          { return "" }
 func (m *mockLoggerConfigService) GetDefaultAdminLastName() string           { return "" }
+func (m *mockLoggerConfigService) IsCSRFEnabled() bool                       { return false }
 func (m *mockLoggerConfigService) GetCSRFSecret() string                     { return "secret" }
 func (m *mockLoggerConfigService) IsCSRFSecure() bool                        { return false }
 func (m *mockLoggerConfigService) IsCSRFHttpOnly() bool                      { return true }
 func (m *mockLoggerConfigService) GetCSRFSameSite() string                   { return "Lax" }
+func (m *mockLoggerConfigService) GetCSRFExemptPaths() []string              { return nil }
 func (m *mockLoggerConfigService) IsRateLimitEnabled() bool                  { return false }
 func (m *mockLoggerConfigService) GetRateLimitRequests() int                 { return 100 }
+func (m *mockLoggerConfigService) IsRateLimitAllRoutesEnabled() bool         { return false }
+func (m *mockLoggerConfigService) GetTrustedProxies() []string               { return nil }
 func (m *mockLoggerConfigService) AreSecurityHeadersEnabled() bool           { return false }
 func (m *mockLoggerConfigService) IsHSTSEnabled() bool                       { return false }
 func (m *mockLoggerConfigService) GetHSTSMaxAge() int                        { return 31536000 }
+func (m *mockLoggerConfigService) GetContentSecurityPolicy() string          { return "" }
 func (m *mockLoggerConfigService) GetSMTPHost() string                       { return "" }
 func (m *mockLoggerConfigService) GetSMTPPort() int                          { return 587 }
 func (m *mockLoggerConfigService) GetSMTPUsername() string                   { return "" }
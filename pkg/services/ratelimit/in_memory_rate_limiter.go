@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+// sweepInterval is how often buckets that refilled completely are removed
+const sweepInterval = time.Minute
+
+// bucket is the token bucket of a single key
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time // when the bucket is full again, it may be removed afterwards
+}
+
+// inMemoryRateLimiterImpl keeps one token bucket per key in memory. Buckets are not shared
+// between instances, so replicated deployments need a RateLimiter backed by a shared store.
+type inMemoryRateLimiterImpl struct {
+	buckets   map[string]*bucket
+	mutex     sync.Mutex
+	lastSweep time.Time
+	now       func() time.Time
+	logger    *zap.Logger
+}
+
+// NewInMemoryRateLimiter creates the default token bucket rate limiter for DI
+func NewInMemoryRateLimiter(i do.Injector) (interfaces.RateLimiter, error) {
+	logger := do.MustInvoke[*zap.Logger](i)
+
+	return newInMemoryRateLimiter(time.Now, logger), nil
+}
+
+func newInMemoryRateLimiter(now func() time.Time, logger *zap.Logger) *inMemoryRateLimiterImpl {
+	return &inMemoryRateLimiterImpl{
+		buckets:   make(map[string]*bucket),
+		lastSweep: now(),
+		now:       now,
+		logger:    logger,
+	}
+}
+
+// Allow takes a token from the bucket of key, refilling requests tokens evenly over per
+func (rl *inMemoryRateLimiterImpl) Allow(key string, requests int, per time.Duration) (bool, time.Duration) {
+	if requests < 1 || per <= 0 {
+		return true, 0
+	}
+
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	now := rl.now()
+	rl.sweep(now)
+
+	capacity := float64(requests)
+	refillRate := capacity / float64(per) // tokens per nanosecond
+
+	b, exists := rl.buckets[key]
+	if !exists {
+		b = &bucket{tokens: capacity, updated: now}
+		rl.buckets[key] = b
+	}
+
+	b.tokens = min(capacity, b.tokens+float64(now.Sub(b.updated))*refillRate)
+	b.updated = now
+
+	if b.tokens < 1 {
+		retryAfter := time.Duration((1 - b.tokens) / refillRate)
+		return false, retryAfter
+	}
+
+	b.tokens--
+	b.full = now.Add(time.Duration((capacity - b.tokens) / refillRate))
+	return true, 0
+}
+
+// sweep removes the buckets that refilled completely, they behave like new buckets
+func (rl *inMemoryRateLimiterImpl) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < sweepInterval {
+		return
+	}
+	rl.lastSweep = now
+
+	removed := 0
+	for key, b := range rl.buckets {
+		if !now.Before(b.full) {
+			delete(rl.buckets, key)
+			removed++
+		}
+	}
+
+	if removed > 0 {
+		rl.logger.Debug("Removed idle rate limit buckets",
+			zap.Int("removed", removed),
+			zap.Int("remaining", len(rl.buckets)))
+	}
+}
@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// testClock is a manually advanced clock
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func TestInMemoryRateLimiterAllow(t *testing.T) {
+	clock := &testClock{now: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := newInMemoryRateLimiter(clock.Now, zap.NewNop())
+
+	// The bucket starts full
+	for i := 0; i < 3; i++ {
+		allowed, _ := limiter.Allow("ip:10.0.0.1", 3, time.Minute)
+		assert.True(t, allowed, "request %d", i+1)
+	}
+
+	allowed, retryAfter := limiter.Allow("ip:10.0.0.1", 3, time.Minute)
+	assert.False(t, allowed)
+	assert.Equal(t, 20*time.Second, retryAfter)
+
+	// Other keys have their own bucket
+	allowed, _ = limiter.Allow("ip:10.0.0.2", 3, time.Minute)
+	assert.True(t, allowed)
+
+	// One token is refilled every 20 seconds
+	clock.now = clock.now.Add(20 * time.Second)
+	allowed, _ = limiter.Allow("ip:10.0.0.1", 3, time.Minute)
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("ip:10.0.0.1", 3, time.Minute)
+	assert.False(t, allowed)
+}
+
+func TestInMemoryRateLimiterSweepsFullBuckets(t *testing.T) {
+	clock := &testClock{now: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := newInMemoryRateLimiter(clock.Now, zap.NewNop())
+
+	limiter.Allow("ip:10.0.0.1", 10, time.Hour)
+	limiter.Allow("ip:10.0.0.2", 10, time.Second)
+
+	clock.now = clock.now.Add(2 * sweepInterval)
+	limiter.Allow("ip:10.0.0.3", 10, time.Second)
+
+	assert.Contains(t, limiter.buckets, "ip:10.0.0.1", "a bucket that is not full yet must be kept")
+	assert.NotContains(t, limiter.buckets, "ip:10.0.0.2")
+	assert.Contains(t, limiter.buckets, "ip:10.0.0.3")
+}
+
+func TestInMemoryRateLimiterIgnoresInvalidLimits(t *testing.T) {
+	limiter := newInMemoryRateLimiter(time.Now, zap.NewNop())
+
+	allowed, _ := limiter.Allow("ip:10.0.0.1", 0, time.Minute)
+	assert.True(t, allowed)
+	assert.Empty(t, limiter.buckets)
+}
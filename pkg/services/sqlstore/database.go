@@ -0,0 +1,60 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+)
+
+// connectTimeout limits the connection check of OpenPostgres
+const connectTimeout = 10 * time.Second
+
+// PostgresDSN builds the key=value connection string of the TR_DATABASE_* settings.
+// Both pgx and lib/pq accept it.
+func PostgresDSN(configService interfaces.ConfigService) string {
+	return strings.Join([]string{
+		"host=" + quoteDSNValue(configService.GetDatabaseHost()),
+		fmt.Sprintf("port=%d", configService.GetDatabasePort()),
+		"user=" + quoteDSNValue(configService.GetDatabaseUser()),
+		"password=" + quoteDSNValue(configService.GetDatabasePassword()),
+		"dbname=" + quoteDSNValue(configService.GetDatabaseName()),
+		"sslmode=" + quoteDSNValue(configService.GetDatabaseSSLMode()),
+	}, " ")
+}
+
+// OpenPostgres opens the database of the TR_DATABASE_* settings and checks the connection.
+// driverName is the name the imported Postgres driver registered, e.g. "pgx" or "postgres".
+func OpenPostgres(configService interfaces.ConfigService, driverName string) (*sql.DB, error) {
+	db, err := sql.Open(driverName, PostgresDSN(configService))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to database %s on %s:%d: %w",
+			configService.GetDatabaseName(),
+			configService.GetDatabaseHost(),
+			configService.GetDatabasePort(),
+			err)
+	}
+
+	return db, nil
+}
+
+// quoteDSNValue quotes a connection string value if it is empty or contains spaces, quotes or backslashes
+func quoteDSNValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, ` '\`) {
+		return value
+	}
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
@@ -0,0 +1,47 @@
+package sqlstore
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Dialect selects the SQL flavour of the database driver
+type Dialect string
+
+const (
+	// DialectPostgres is used with Postgres drivers such as pgx or lib/pq
+	DialectPostgres Dialect = "postgres"
+	// DialectSQLite is used with SQLite drivers such as modernc.org/sqlite
+	DialectSQLite Dialect = "sqlite"
+)
+
+// validate checks that the dialect is supported
+func (d Dialect) validate() error {
+	switch d {
+	case DialectPostgres, DialectSQLite:
+		return nil
+	}
+	return fmt.Errorf("unsupported SQL dialect %q", string(d))
+}
+
+// rebind converts the ? placeholders of query to the placeholders of the dialect.
+// The queries of this package contain no string literals, so every ? is a placeholder.
+func (d Dialect) rebind(query string) string {
+	if d != DialectPostgres {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, c := range query {
+		if c != '?' {
+			b.WriteRune(c)
+			continue
+		}
+		n++
+		b.WriteByte('$')
+		b.WriteString(strconv.Itoa(n))
+	}
+	return b.String()
+}
@@ -0,0 +1,135 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// migrationFiles holds the schema migrations, they are applied in file name order
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockID is the Postgres advisory lock that serializes migrations of several instances
+const migrationLockID = 7353110
+
+// Migrate applies the embedded schema migrations that were not applied to db yet.
+// NewSessionStore and NewUserStore migrate themselves, call Migrate to do it ahead of time.
+func Migrate(ctx context.Context, db *sql.DB, dialect Dialect) error {
+	if err := dialect.validate(); err != nil {
+		return err
+	}
+
+	names, err := fs.Glob(migrationFiles, "migrations/*.sql")
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+	sort.Strings(names)
+
+	// All migration statements run on one connection, the advisory lock belongs to it
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %w", err)
+	}
+	defer conn.Close()
+
+	if dialect == DialectPostgres {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+			return fmt.Errorf("failed to lock migrations: %w", err)
+		}
+		defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID)
+	}
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS tr_schema_migrations (
+	version    VARCHAR(255) PRIMARY KEY,
+	applied_at BIGINT       NOT NULL
+)`); err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	applied, err := appliedMigrations(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		version := strings.TrimSuffix(path.Base(name), ".sql")
+		if applied[version] {
+			continue
+		}
+		if err := applyMigration(ctx, conn, dialect, name, version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appliedMigrations returns the versions recorded in tr_schema_migrations
+func appliedMigrations(ctx context.Context, conn *sql.Conn) (map[string]bool, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version FROM tr_schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[string]bool{}
+	for rows.Next() {
+		var version string
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+		}
+		applied[version] = true
+	}
+	return applied, rows.Err()
+}
+
+// applyMigration runs the statements of a migration file and records its version in one transaction
+func applyMigration(ctx context.Context, conn *sql.Conn, dialect Dialect, name, version string) error {
+	content, err := migrationFiles.ReadFile(name)
+	if err != nil {
+		return fmt.Errorf("failed to read migration %s: %w", version, err)
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %s: %w", version, err)
+	}
+	defer tx.Rollback()
+
+	for _, statement := range splitStatements(string(content)) {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to apply migration %s: %w", version, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		dialect.rebind("INSERT INTO tr_schema_migrations (version, applied_at) VALUES (?, ?)"),
+		version, time.Now().UnixMilli()); err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %s: %w", version, err)
+	}
+	return nil
+}
+
+// splitStatements splits a migration file into its statements. Migrations contain no
+// string literals with semicolons, so splitting at every semicolon is enough.
+func splitStatements(content string) []string {
+	var statements []string
+	for _, statement := range strings.Split(content, ";") {
+		if statement = strings.TrimSpace(statement); statement != "" {
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
@@ -0,0 +1,8 @@
+CREATE TABLE tr_users (
+	id            VARCHAR(64)  PRIMARY KEY,
+	username      VARCHAR(255) NOT NULL UNIQUE,
+	email         VARCHAR(255) NOT NULL UNIQUE,
+	password_hash VARCHAR(255) NOT NULL,
+	roles         VARCHAR(255) NOT NULL DEFAULT '',
+	created_at    BIGINT       NOT NULL
+);
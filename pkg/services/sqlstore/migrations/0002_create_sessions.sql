@@ -0,0 +1,12 @@
+-- user_id has no foreign key to tr_users, the session store works with any UserStore
+CREATE TABLE tr_sessions (
+	id         VARCHAR(64)  PRIMARY KEY,
+	user_id    VARCHAR(255) NOT NULL,
+	remember   BOOLEAN      NOT NULL DEFAULT FALSE,
+	created_at BIGINT       NOT NULL,
+	expires_at BIGINT       NOT NULL
+);
+
+CREATE INDEX tr_sessions_user_id ON tr_sessions (user_id);
+
+CREATE INDEX tr_sessions_expires_at ON tr_sessions (expires_at);
@@ -0,0 +1,231 @@
+package sqlstore
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/denkhaus/templ-router/pkg/services/auth"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+// sessionCleanupInterval is how often expired sessions are deleted
+const sessionCleanupInterval = time.Hour
+
+// sqlSessionStore keeps sessions in the tr_sessions table, so they survive restarts
+// and are shared by all instances (private implementation)
+type sqlSessionStore struct {
+	db            *sql.DB
+	dialect       Dialect
+	logger        *zap.Logger
+	configService interfaces.ConfigService
+	now           func() time.Time
+	stop          chan struct{}
+	stopOnce      sync.Once
+}
+
+// NewSessionStore creates a session store on db and migrates the schema. Register it with
+// di.WithSessionStore; the store does not close db.
+func NewSessionStore(i do.Injector, db *sql.DB, dialect Dialect) (interfaces.SessionStore, error) {
+	logger := do.MustInvoke[*zap.Logger](i)
+	configService := do.MustInvoke[interfaces.ConfigService](i)
+
+	if err := Migrate(context.Background(), db, dialect); err != nil {
+		return nil, err
+	}
+
+	store := &sqlSessionStore{
+		db:            db,
+		dialect:       dialect,
+		logger:        logger,
+		configService: configService,
+		now:           time.Now,
+		stop:          make(chan struct{}),
+	}
+
+	// Start cleanup routine for expired sessions
+	go store.cleanupExpiredSessions()
+
+	return store, nil
+}
+
+// GetSession retrieves the session of the request cookie and slides its expiry
+func (s *sqlSessionStore) GetSession(req *http.Request) (*interfaces.Session, error) {
+	cookie, err := req.Cookie(s.configService.GetSessionCookieName())
+	if err != nil {
+		return nil, fmt.Errorf("no session cookie found")
+	}
+
+	ctx := req.Context()
+	session, err := s.findSession(ctx, cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if now.After(session.ExpiresAt) {
+		s.DeleteSession(session.ID)
+		return nil, fmt.Errorf("session expired")
+	}
+
+	if auth.RenewSession(session, auth.SessionExpiry(s.configService, session.Remember), now) {
+		if _, err := s.db.ExecContext(ctx,
+			s.dialect.rebind("UPDATE tr_sessions SET expires_at = ? WHERE id = ?"),
+			session.ExpiresAt.UnixMilli(), session.ID); err != nil {
+			return nil, fmt.Errorf("failed to renew session: %w", err)
+		}
+	}
+
+	return session, nil
+}
+
+// CreateSession creates a new session for a user
+func (s *sqlSessionStore) CreateSession(userID string, remember bool) (*interfaces.Session, error) {
+	sessionID, err := generateSessionID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate session ID: %w", err)
+	}
+
+	now := s.now()
+	session := &interfaces.Session{
+		ID:        sessionID,
+		UserID:    userID,
+		Valid:     true,
+		CreatedAt: now,
+		ExpiresAt: now.Add(auth.SessionExpiry(s.configService, remember)),
+		Remember:  remember,
+	}
+
+	if _, err := s.db.Exec(
+		s.dialect.rebind("INSERT INTO tr_sessions (id, user_id, remember, created_at, expires_at) VALUES (?, ?, ?, ?, ?)"),
+		session.ID, session.UserID, session.Remember, session.CreatedAt.UnixMilli(), session.ExpiresAt.UnixMilli()); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	s.logger.Info("Session created",
+		zap.String("user_id", userID),
+		zap.Bool("remember", remember))
+
+	return session, nil
+}
+
+// RotateSession moves a session to a new ID with a fresh expiry, the old ID becomes invalid
+func (s *sqlSessionStore) RotateSession(sessionID string) (*interfaces.Session, error) {
+	ctx := context.Background()
+	session, err := s.findSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	newID, err := generateSessionID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate session ID: %w", err)
+	}
+
+	now := s.now()
+	expiresAt := now.Add(auth.SessionExpiry(s.configService, session.Remember))
+
+	// The old ID only moves if the session is still valid, so a concurrent rotation or sign out wins
+	result, err := s.db.ExecContext(ctx,
+		s.dialect.rebind("UPDATE tr_sessions SET id = ?, expires_at = ? WHERE id = ? AND expires_at > ?"),
+		newID, expiresAt.UnixMilli(), sessionID, now.UnixMilli())
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate session: %w", err)
+	}
+	if rows, err := result.RowsAffected(); err != nil || rows != 1 {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	s.logger.Info("Session rotated", zap.String("user_id", session.UserID))
+
+	session.ID = newID
+	session.ExpiresAt = expiresAt
+	return session, nil
+}
+
+// DeleteSession deletes a session
+func (s *sqlSessionStore) DeleteSession(sessionID string) error {
+	if _, err := s.db.Exec(s.dialect.rebind("DELETE FROM tr_sessions WHERE id = ?"), sessionID); err != nil {
+		s.logger.Error("Failed to delete session", zap.Error(err))
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	s.logger.Info("Session deleted")
+	return nil
+}
+
+// WriteSessionCookie sets the session cookie, it expires together with the session
+func (s *sqlSessionStore) WriteSessionCookie(w http.ResponseWriter, session *interfaces.Session) {
+	auth.WriteSessionCookie(w, s.configService, session, s.now())
+}
+
+// ClearSessionCookie removes the session cookie
+func (s *sqlSessionStore) ClearSessionCookie(w http.ResponseWriter) {
+	auth.ClearSessionCookie(w, s.configService)
+}
+
+// Shutdown stops the cleanup routine, the DI container calls it on shutdown
+func (s *sqlSessionStore) Shutdown() error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	return nil
+}
+
+// findSession loads a session by ID
+func (s *sqlSessionStore) findSession(ctx context.Context, sessionID string) (*interfaces.Session, error) {
+	var createdAt, expiresAt int64
+	session := &interfaces.Session{Valid: true}
+
+	err := s.db.QueryRowContext(ctx,
+		s.dialect.rebind("SELECT id, user_id, remember, created_at, expires_at FROM tr_sessions WHERE id = ?"),
+		sessionID).Scan(&session.ID, &session.UserID, &session.Remember, &createdAt, &expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("session not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load session: %w", err)
+	}
+
+	session.CreatedAt = time.UnixMilli(createdAt)
+	session.ExpiresAt = time.UnixMilli(expiresAt)
+	return session, nil
+}
+
+// cleanupExpiredSessions deletes expired sessions until the store is shut down
+func (s *sqlSessionStore) cleanupExpiredSessions() {
+	ticker := time.NewTicker(sessionCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+
+		result, err := s.db.Exec(s.dialect.rebind("DELETE FROM tr_sessions WHERE expires_at < ?"), s.now().UnixMilli())
+		if err != nil {
+			s.logger.Error("Failed to clean up expired sessions", zap.Error(err))
+			continue
+		}
+		if count, err := result.RowsAffected(); err == nil && count > 0 {
+			s.logger.Info("Cleaned up expired sessions", zap.Int64("count", count))
+		}
+	}
+}
+
+// generateSessionID generates a cryptographically secure session ID
+func generateSessionID() (string, error) {
+	bytes := make([]byte, 32) // 256 bits
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
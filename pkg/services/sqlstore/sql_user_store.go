@@ -0,0 +1,278 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrUserNotFound is returned when no user has the requested ID or email
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUserExists is returned when the username or email of a new user is taken
+	ErrUserExists = errors.New("user already exists")
+	// ErrInvalidCredentials is returned for an unknown email or a wrong password
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// defaultUserRoles are the roles of users created by CreateUser
+var defaultUserRoles = []string{"user"}
+
+// User is the user entity of the SQL user store, read it with router.GetCurrentUser[*sqlstore.User]
+type User struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Roles     []string  `json:"roles"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Implement UserEntity interface
+func (u *User) GetID() string      { return u.ID }
+func (u *User) GetEmail() string   { return u.Email }
+func (u *User) GetRoles() []string { return u.Roles }
+
+// sqlUserStore is a reference UserStore on the tr_users table (private implementation)
+type sqlUserStore struct {
+	db         *sql.DB
+	dialect    Dialect
+	logger     *zap.Logger
+	iterations int
+	now        func() time.Time
+
+	// dummyHash is verified for unknown emails, so they take as long as wrong passwords
+	dummyHash func() (string, error)
+}
+
+// NewUserStore creates a user store on db, migrates the schema and creates the default
+// admin if TR_AUTH_CREATE_DEFAULT_ADMIN is set. Register it with di.WithUserStore; the
+// store does not close db.
+func NewUserStore(i do.Injector, db *sql.DB, dialect Dialect) (interfaces.UserStore, error) {
+	logger := do.MustInvoke[*zap.Logger](i)
+	configService := do.MustInvoke[interfaces.ConfigService](i)
+
+	if err := Migrate(context.Background(), db, dialect); err != nil {
+		return nil, err
+	}
+
+	store := newSQLUserStore(db, dialect, logger, passwordIterations)
+	if configService.ShouldCreateDefaultAdmin() {
+		if err := store.createDefaultAdmin(configService); err != nil {
+			return nil, err
+		}
+	}
+
+	return store, nil
+}
+
+// newSQLUserStore creates the store, iterations is the password hashing work factor
+func newSQLUserStore(db *sql.DB, dialect Dialect, logger *zap.Logger, iterations int) *sqlUserStore {
+	return &sqlUserStore{
+		db:         db,
+		dialect:    dialect,
+		logger:     logger,
+		iterations: iterations,
+		now:        time.Now,
+		dummyHash: sync.OnceValues(func() (string, error) {
+			return hashPassword("", iterations)
+		}),
+	}
+}
+
+// GetUserByID retrieves a user by ID
+func (s *sqlUserStore) GetUserByID(userID string) (interfaces.UserEntity, error) {
+	user, _, err := s.findUser("id = ?", userID)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetUserByEmail retrieves a user by email, emails are compared case-insensitively
+func (s *sqlUserStore) GetUserByEmail(email string) (interfaces.UserEntity, error) {
+	user, _, err := s.findUser("email = ?", normalizeEmail(email))
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ValidateCredentials validates the password of the user with email
+func (s *sqlUserStore) ValidateCredentials(email, password string) (interfaces.UserEntity, error) {
+	user, passwordHash, err := s.findUser("email = ?", normalizeEmail(email))
+	if errors.Is(err, ErrUserNotFound) {
+		if passwordHash, err = s.dummyHash(); err == nil {
+			verifyPassword(password, passwordHash)
+		}
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := verifyPassword(password, passwordHash)
+	if err != nil {
+		s.logger.Error("Failed to verify password",
+			zap.String("user_id", user.ID),
+			zap.Error(err))
+		return nil, ErrInvalidCredentials
+	}
+	if !valid {
+		return nil, ErrInvalidCredentials
+	}
+
+	s.logger.Info("User authenticated successfully", zap.String("user_id", user.ID))
+	return user, nil
+}
+
+// CreateUser creates a user with the default roles
+func (s *sqlUserStore) CreateUser(username, email, password string) (interfaces.UserEntity, error) {
+	return s.createUser(username, email, password, defaultUserRoles)
+}
+
+// UserExists checks if a user exists by username or email
+func (s *sqlUserStore) UserExists(username, email string) (bool, error) {
+	var count int
+	err := s.db.QueryRow(
+		s.dialect.rebind("SELECT COUNT(*) FROM tr_users WHERE username = ? OR email = ?"),
+		strings.TrimSpace(username), normalizeEmail(email)).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check user: %w", err)
+	}
+	return count > 0, nil
+}
+
+// ValidateCredentialsFromRequest validates the "email" and "password" form fields
+func (s *sqlUserStore) ValidateCredentialsFromRequest(req *http.Request) (interfaces.UserEntity, error) {
+	email := req.FormValue("email")
+	password := req.FormValue("password")
+
+	if email == "" || password == "" {
+		return nil, fmt.Errorf("email and password are required")
+	}
+
+	return s.ValidateCredentials(email, password)
+}
+
+// CreateUserFromRequest creates a user from the "username", "email" and "password" form fields
+func (s *sqlUserStore) CreateUserFromRequest(req *http.Request) (interfaces.UserEntity, error) {
+	username := req.FormValue("username")
+	email := req.FormValue("email")
+	password := req.FormValue("password")
+
+	if username == "" || email == "" || password == "" {
+		return nil, fmt.Errorf("username, email and password are required")
+	}
+
+	return s.CreateUser(username, email, password)
+}
+
+// createUser stores a new user with a hashed password
+func (s *sqlUserStore) createUser(username, email, password string, roles []string) (*User, error) {
+	username = strings.TrimSpace(username)
+	email = normalizeEmail(email)
+
+	exists, err := s.UserExists(username, email)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrUserExists
+	}
+
+	passwordHash, err := hashPassword(password, s.iterations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user := &User{
+		ID:        uuid.NewString(),
+		Username:  username,
+		Email:     email,
+		Roles:     roles,
+		CreatedAt: time.UnixMilli(s.now().UnixMilli()),
+	}
+
+	if _, err := s.db.Exec(
+		s.dialect.rebind("INSERT INTO tr_users (id, username, email, password_hash, roles, created_at) VALUES (?, ?, ?, ?, ?, ?)"),
+		user.ID, user.Username, user.Email, passwordHash, strings.Join(user.Roles, ","), user.CreatedAt.UnixMilli()); err != nil {
+		// A concurrent sign up may have taken the username or email since UserExists
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	s.logger.Info("User created successfully",
+		zap.String("user_id", user.ID),
+		zap.String("username", user.Username))
+
+	return user, nil
+}
+
+// createDefaultAdmin creates the TR_AUTH_DEFAULT_ADMIN_* user unless its email is taken
+func (s *sqlUserStore) createDefaultAdmin(configService interfaces.ConfigService) error {
+	email := configService.GetDefaultAdminEmail()
+	if _, err := s.GetUserByEmail(email); err == nil {
+		return nil
+	} else if !errors.Is(err, ErrUserNotFound) {
+		return err
+	}
+
+	_, err := s.createUser("admin", email, configService.GetDefaultAdminPassword(), []string{"admin", "user"})
+	if errors.Is(err, ErrUserExists) {
+		s.logger.Warn("Default admin not created, username admin is taken", zap.String("email", email))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create default admin: %w", err)
+	}
+	return nil
+}
+
+// findUser loads the user matching condition, e.g. "id = ?", and its password hash
+func (s *sqlUserStore) findUser(condition string, value string) (*User, string, error) {
+	var (
+		user         User
+		roles        string
+		passwordHash string
+		createdAt    int64
+	)
+
+	err := s.db.QueryRow(
+		s.dialect.rebind("SELECT id, username, email, password_hash, roles, created_at FROM tr_users WHERE "+condition),
+		value).Scan(&user.ID, &user.Username, &user.Email, &passwordHash, &roles, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", ErrUserNotFound
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load user: %w", err)
+	}
+
+	user.Roles = splitRoles(roles)
+	user.CreatedAt = time.UnixMilli(createdAt)
+	return &user, passwordHash, nil
+}
+
+// splitRoles parses the comma separated roles column
+func splitRoles(roles string) []string {
+	result := []string{}
+	for _, role := range strings.Split(roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			result = append(result, role)
+		}
+	}
+	return result
+}
+
+// normalizeEmail makes emails unique regardless of case and surrounding spaces
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
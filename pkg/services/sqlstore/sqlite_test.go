@@ -0,0 +1,177 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// storeTestConfigService provides the session and default admin settings, other methods are not used
+type storeTestConfigService struct {
+	interfaces.ConfigService
+	createAdmin bool
+}
+
+func (m *storeTestConfigService) GetSessionCookieName() string            { return "session_id" }
+func (m *storeTestConfigService) GetSessionExpiry() time.Duration         { return time.Hour }
+func (m *storeTestConfigService) GetSessionRememberExpiry() time.Duration { return 30 * 24 * time.Hour }
+func (m *storeTestConfigService) IsSessionSecure() bool                   { return true }
+func (m *storeTestConfigService) IsSessionHttpOnly() bool                 { return true }
+func (m *storeTestConfigService) GetSessionSameSite() string              { return "lax" }
+func (m *storeTestConfigService) ShouldCreateDefaultAdmin() bool          { return m.createAdmin }
+func (m *storeTestConfigService) GetDefaultAdminEmail() string            { return "admin@example.com" }
+func (m *storeTestConfigService) GetDefaultAdminPassword() string         { return "admin123" }
+
+func newTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "store.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newTestInjector(createAdmin bool) do.Injector {
+	i := do.New()
+	do.ProvideValue(i, zap.NewNop())
+	do.ProvideValue[interfaces.ConfigService](i, &storeTestConfigService{createAdmin: createAdmin})
+	return i
+}
+
+func newTestSQLSessionStore(t *testing.T, db *sql.DB) *sqlSessionStore {
+	store, err := NewSessionStore(newTestInjector(false), db, DialectSQLite)
+	require.NoError(t, err)
+	t.Cleanup(func() { store.(*sqlSessionStore).Shutdown() })
+	return store.(*sqlSessionStore)
+}
+
+func sessionRequest(sessionID string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.AddCookie(&http.Cookie{Name: "session_id", Value: sessionID})
+	return r
+}
+
+func TestMigrateIsIdempotent(t *testing.T) {
+	db := newTestDB(t)
+
+	require.NoError(t, Migrate(context.Background(), db, DialectSQLite))
+	require.NoError(t, Migrate(context.Background(), db, DialectSQLite))
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM tr_schema_migrations").Scan(&count))
+	assert.Equal(t, 2, count)
+}
+
+func TestSQLUserStore(t *testing.T) {
+	store := newSQLUserStore(newTestDB(t), DialectSQLite, zap.NewNop(), 1000)
+	require.NoError(t, Migrate(context.Background(), store.db, DialectSQLite))
+
+	created, err := store.CreateUser("alice", " Alice@Example.com ", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", created.GetEmail())
+	assert.Equal(t, []string{"user"}, created.GetRoles())
+
+	user, err := store.GetUserByID(created.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, created, user)
+
+	_, err = store.CreateUser("alice2", "ALICE@example.com", "secret")
+	assert.ErrorIs(t, err, ErrUserExists)
+	_, err = store.CreateUser("alice", "other@example.com", "secret")
+	assert.ErrorIs(t, err, ErrUserExists)
+
+	user, err = store.ValidateCredentials("alice@EXAMPLE.com", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, created.GetID(), user.GetID())
+
+	_, err = store.ValidateCredentials("alice@example.com", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = store.ValidateCredentials("bob@example.com", "secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = store.GetUserByEmail("bob@example.com")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
+
+func TestSQLUserStoreCreatesDefaultAdmin(t *testing.T) {
+	db := newTestDB(t)
+
+	store, err := NewUserStore(newTestInjector(true), db, DialectSQLite)
+	require.NoError(t, err)
+	admin, err := store.ValidateCredentials("admin@example.com", "admin123")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin", "user"}, admin.GetRoles())
+
+	// A second instance keeps the existing admin
+	_, err = NewUserStore(newTestInjector(true), db, DialectSQLite)
+	require.NoError(t, err)
+	user, err := store.GetUserByEmail("admin@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, admin.GetID(), user.GetID())
+}
+
+func TestSQLSessionStore(t *testing.T) {
+	db := newTestDB(t)
+	store := newTestSQLSessionStore(t, db)
+	now := time.UnixMilli(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC).UnixMilli())
+	store.now = func() time.Time { return now }
+
+	created, err := store.CreateSession("user-1", false)
+	require.NoError(t, err)
+
+	// Sessions survive a restart of the application
+	restarted := newTestSQLSessionStore(t, db)
+	restarted.now = store.now
+	session, err := restarted.GetSession(sessionRequest(created.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", session.UserID)
+	assert.False(t, session.Renewed)
+	assert.True(t, session.ExpiresAt.Equal(now.Add(time.Hour)))
+
+	// Less than half of the expiry is left, the expiry slides
+	now = now.Add(40 * time.Minute)
+	session, err = store.GetSession(sessionRequest(created.ID))
+	require.NoError(t, err)
+	assert.True(t, session.Renewed)
+	assert.True(t, session.ExpiresAt.Equal(now.Add(time.Hour)))
+
+	rotated, err := store.RotateSession(created.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, created.ID, rotated.ID)
+	_, err = store.GetSession(sessionRequest(created.ID))
+	assert.Error(t, err)
+	_, err = store.RotateSession(created.ID)
+	assert.Error(t, err)
+
+	require.NoError(t, store.DeleteSession(rotated.ID))
+	_, err = store.GetSession(sessionRequest(rotated.ID))
+	assert.Error(t, err)
+}
+
+func TestSQLSessionStoreRememberAndExpiry(t *testing.T) {
+	store := newTestSQLSessionStore(t, newTestDB(t))
+	now := time.UnixMilli(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC).UnixMilli())
+	store.now = func() time.Time { return now }
+
+	remembered, err := store.CreateSession("user-1", true)
+	require.NoError(t, err)
+	assert.True(t, remembered.ExpiresAt.Equal(now.Add(30*24*time.Hour)))
+
+	short, err := store.CreateSession("user-1", false)
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Hour)
+	_, err = store.GetSession(sessionRequest(short.ID))
+	assert.Error(t, err)
+	session, err := store.GetSession(sessionRequest(remembered.ID))
+	require.NoError(t, err)
+	assert.True(t, session.Remember)
+}
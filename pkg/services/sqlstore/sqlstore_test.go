@@ -0,0 +1,78 @@
+package sqlstore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/denkhaus/templ-router/pkg/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// databaseTestConfigService provides the TR_DATABASE_* settings, other methods are not used
+type databaseTestConfigService struct {
+	interfaces.ConfigService
+	password string
+}
+
+func (m *databaseTestConfigService) GetDatabaseHost() string     { return "db.internal" }
+func (m *databaseTestConfigService) GetDatabasePort() int        { return 5432 }
+func (m *databaseTestConfigService) GetDatabaseUser() string     { return "router" }
+func (m *databaseTestConfigService) GetDatabasePassword() string { return m.password }
+func (m *databaseTestConfigService) GetDatabaseName() string     { return "router_db" }
+func (m *databaseTestConfigService) GetDatabaseSSLMode() string  { return "require" }
+
+func TestDialectRebind(t *testing.T) {
+	query := "UPDATE tr_sessions SET expires_at = ? WHERE id = ?"
+
+	assert.Equal(t, "UPDATE tr_sessions SET expires_at = $1 WHERE id = $2", DialectPostgres.rebind(query))
+	assert.Equal(t, query, DialectSQLite.rebind(query))
+	assert.Error(t, Dialect("mysql").validate())
+}
+
+func TestPostgresDSN(t *testing.T) {
+	dsn := PostgresDSN(&databaseTestConfigService{password: "secret"})
+	assert.Equal(t, "host=db.internal port=5432 user=router password=secret dbname=router_db sslmode=require", dsn)
+
+	// Values with spaces, quotes or backslashes are quoted, empty values too
+	dsn = PostgresDSN(&databaseTestConfigService{password: `it's a \secret`})
+	assert.Contains(t, dsn, `password='it\'s a \\secret'`)
+	dsn = PostgresDSN(&databaseTestConfigService{})
+	assert.Contains(t, dsn, "password='' ")
+}
+
+func TestMigrationStatements(t *testing.T) {
+	content, err := migrationFiles.ReadFile("migrations/0002_create_sessions.sql")
+	require.NoError(t, err)
+
+	statements := splitStatements(string(content))
+	require.Len(t, statements, 3)
+	assert.True(t, strings.HasSuffix(statements[0], ")"))
+	assert.Contains(t, statements[0], "CREATE TABLE tr_sessions")
+	assert.Equal(t, "CREATE INDEX tr_sessions_user_id ON tr_sessions (user_id)", statements[1])
+}
+
+func TestPasswordHash(t *testing.T) {
+	hash, err := hashPassword("correct horse", 1000)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "pbkdf2-sha256$1000$"))
+	assert.NotContains(t, hash, "correct horse")
+
+	valid, err := verifyPassword("correct horse", hash)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = verifyPassword("wrong horse", hash)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	// Every hash gets its own salt
+	other, err := hashPassword("correct horse", 1000)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+
+	for _, invalid := range []string{"", "correct horse", "bcrypt$10$a$b", "pbkdf2-sha256$0$a$b", "pbkdf2-sha256$1000$!$b"} {
+		_, err := verifyPassword("correct horse", invalid)
+		assert.ErrorIs(t, err, errInvalidPasswordHash, invalid)
+	}
+}
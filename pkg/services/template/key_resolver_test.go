@@ -48,6 +48,7 @@ func (m *mockTemplateConfigService) IsEmailVerificationRequired() bool         {
 func (m *mockTemplateConfigService) GetVerificationTokenExpiry() time.Duration { return 24 * time.Hour }
 func (m *mockTemplateConfigService) GetSessionCookieName() string              { return "session" }
 func (m *mockTemplateConfigService) GetSessionExpiry() time.Duration           { return 24 * time.Hour }
+func (m *mockTemplateConfigService) GetSessionRememberExpiry() time.Duration   { return 30 * 24 * time.Hour }
 func (m *mockTemplateConfigService) IsSessionSecure() bool                     { return false }
 func (m *mockTemplateConfigService) IsSessionHttpOnly() bool                   { return true }
 func (m *mockTemplateConfigService) GetSessionSameSite() string                { return "Lax" }
@@ -58,15 +59,20 @@ func (m *mockTemplateConfigService) GetDefaultAdminEmail() string              {
 func (m *mockTemplateConfigService) GetDefaultAdminPassword() string           { return "" }
 func (m *mockTemplateConfigService) GetDefaultAdminFirstName() string          { return "" }
 func (m *mockTemplateConfigService) GetDefaultAdminLastName() string           { return "" }
+func (m *mockTemplateConfigService) IsCSRFEnabled() bool                       { return false }
 func (m *mockTemplateConfigService) GetCSRFSecret() string                     { return "secret" }
 func (m *mockTemplateConfigService) IsCSRFSecure() bool                        { return false }
 func (m *mockTemplateConfigService) IsCSRFHttpOnly() bool                      { return true }
 func (m *mockTemplateConfigService) GetCSRFSameSite() string                   { return "Lax" }
+func (m *mockTemplateConfigService) GetCSRFExemptPaths() []string              { return nil }
 func (m *mockTemplateConfigService) IsRateLimitEnabled() bool                  { return false }
 func (m *mockTemplateConfigService) GetRateLimitRequests() int                 { return 100 }
+func (m *mockTemplateConfigService) IsRateLimitAllRoutesEnabled() bool         { return false }
+func (m *mockTemplateConfigService) GetTrustedProxies() []string               { return nil }
 func (m *mockTemplateConfigService) AreSecurityHeadersEnabled() bool           { return false }
 func (m *mockTemplateConfigService) IsHSTSEnabled() bool                       { return false }
 func (m *mockTemplateConfigService) GetHSTSMaxAge() int                        { return 31536000 }
+func (m *mockTemplateConfigService) GetContentSecurityPolicy() string          { return "" }
 func (m *mockTemplateConfigService) GetLogLevel() string                       { return "info" }
 func (m *mockTemplateConfigService) GetLogFormat() string                      { return "json" }
 func (m *mockTemplateConfigService) GetLogOutput() string                      { return "stdout" }
@@ -291,3 +297,7 @@ func (m *mockTemplateConfigService) GetSignInRoute() string { return "/login" }
 func (m *mockTemplateConfigService) GetRouterEnableTrailingSlash() bool     { return true }
 func (m *mockTemplateConfigService) GetRouterEnableSlashRedirect() bool     { return true }
 func (m *mockTemplateConfigService) GetRouterEnableMethodNotAllowed() bool  { return true }
+func (m *mockTemplateConfigService) GetRouterParameterValidationStatus() int { return 404 }
+func (m *mockTemplateConfigService) GetRouterValidationMode() string { return "warn" }
+func (m *mockTemplateConfigService) GetRouterBasePath() string { return "" }
+func (m *mockTemplateConfigService) GetRouterRenderMode() string { return "direct" }
@@ -0,0 +1,13 @@
+package shared
+
+// ActionFunctionName is the function a page package exports to handle its form submissions
+const ActionFunctionName = "Action"
+
+// ActionMethods are the request methods served by the Action function of a page
+var ActionMethods = []string{"POST", "PUT", "DELETE"}
+
+// PageActionKey identifies the Action function of the page package of a template file,
+// e.g. "app/contact/page.templ" -> "app/contact#action"
+func PageActionKey(templatePath string) string {
+	return PackageComponentKey(templatePath, ActionFunctionName)
+}
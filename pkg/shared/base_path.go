@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+)
+
+// BasePathKey stores the mount prefix of the router in the request context
+const BasePathKey ContextType = "router_base_path"
+
+// urlBasePath is the mount prefix applied by generated URL builders without a context
+var urlBasePath atomic.Value
+
+// NormalizeBasePath returns a mount prefix with a leading and without a trailing slash,
+// or "" for the root, e.g. "portal/" -> "/portal", "/" -> ""
+func NormalizeBasePath(basePath string) string {
+	basePath = strings.Trim(strings.TrimSpace(basePath), "/")
+	if basePath == "" {
+		return ""
+	}
+	return "/" + basePath
+}
+
+// JoinBasePath prefixes an internal absolute path with the mount prefix.
+// External URLs and relative paths are returned unchanged.
+// e.g., ("/portal", "/en/dashboard") -> "/portal/en/dashboard"
+func JoinBasePath(basePath, path string) string {
+	if basePath == "" || !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return path
+	}
+	return basePath + path
+}
+
+// WithBasePath stores the mount prefix in the context
+func WithBasePath(ctx context.Context, basePath string) context.Context {
+	return context.WithValue(ctx, BasePathKey, basePath)
+}
+
+// GetBasePath returns the mount prefix from the context ("" when mounted at the root)
+func GetBasePath(ctx context.Context) string {
+	basePath, _ := ctx.Value(BasePathKey).(string)
+	return basePath
+}
+
+// SetURLBasePath sets the mount prefix applied by generated URL builders without a context.
+// The router sets it when registering its routes; the Ctx builders use GetBasePath instead.
+func SetURLBasePath(basePath string) {
+	urlBasePath.Store(NormalizeBasePath(basePath))
+}
+
+// URLBasePath returns the mount prefix applied by generated URL builders without a context ("" for the root)
+func URLBasePath() string {
+	basePath, _ := urlBasePath.Load().(string)
+	return basePath
+}
@@ -0,0 +1,37 @@
+package shared
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeBasePath(t *testing.T) {
+	assert.Equal(t, "/portal", NormalizeBasePath("portal/"))
+	assert.Equal(t, "/portal/app", NormalizeBasePath("/portal/app/"))
+	assert.Equal(t, "", NormalizeBasePath("/"))
+	assert.Equal(t, "", NormalizeBasePath(""))
+}
+
+func TestJoinBasePath(t *testing.T) {
+	assert.Equal(t, "/portal/en/dashboard", JoinBasePath("/portal", "/en/dashboard"))
+	assert.Equal(t, "/portal/", JoinBasePath("/portal", "/"))
+	assert.Equal(t, "/en/dashboard", JoinBasePath("", "/en/dashboard"))
+	assert.Equal(t, "https://example.com/login", JoinBasePath("/portal", "https://example.com/login"))
+	assert.Equal(t, "//cdn.example.com/app.js", JoinBasePath("/portal", "//cdn.example.com/app.js"))
+	assert.Equal(t, "relative", JoinBasePath("/portal", "relative"))
+}
+
+func TestBasePathContext(t *testing.T) {
+	assert.Equal(t, "", GetBasePath(context.Background()))
+	assert.Equal(t, "/portal", GetBasePath(WithBasePath(context.Background(), "/portal")))
+}
+
+func TestURLBasePath(t *testing.T) {
+	t.Cleanup(func() { SetURLBasePath("") })
+
+	assert.Equal(t, "", URLBasePath())
+	SetURLBasePath("portal/")
+	assert.Equal(t, "/portal", URLBasePath())
+}
@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// JSON content negotiation of page routes
+const (
+	// JSONContentType is the media type of JSON responses
+	JSONContentType = "application/json"
+
+	// JSONSuffix requests the JSON representation of a page, e.g. /users/42.json
+	JSONSuffix = ".json"
+
+	// FormatQueryParam selects the representation of a page, e.g. /users/42?format=json
+	FormatQueryParam = "format"
+
+	// FormatJSON is the FormatQueryParam value for the JSON representation
+	FormatJSON = "json"
+)
+
+// WantsJSON checks if the request asks for the JSON representation of a page:
+// by a .json suffix on the path, by ?format=json or by an Accept header that lists
+// application/json before text/html
+func WantsJSON(r *http.Request) bool {
+	if IsJSONSuffixRequest(r) {
+		return true
+	}
+
+	if format := r.URL.Query().Get(FormatQueryParam); format != "" {
+		return strings.EqualFold(format, FormatJSON)
+	}
+
+	return acceptsJSONFirst(r.Header.Get("Accept"))
+}
+
+// IsJSONSuffixRequest checks if the request was dispatched without its .json path suffix
+func IsJSONSuffixRequest(r *http.Request) bool {
+	suffix, _ := r.Context().Value(JSONSuffixKey).(bool)
+	return suffix
+}
+
+// TrimJSONSuffix removes the .json suffix from a request path.
+// Returns false if the path has no suffix or nothing is left without it.
+func TrimJSONSuffix(path string) (string, bool) {
+	trimmed, ok := strings.CutSuffix(path, JSONSuffix)
+	if !ok || trimmed == "" || strings.HasSuffix(trimmed, "/") {
+		return path, false
+	}
+	return trimmed, true
+}
+
+// acceptsJSONFirst reports whether application/json comes before text/html in an
+// Accept header; browsers list text/html first, API clients only ask for JSON
+func acceptsJSONFirst(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case JSONContentType:
+			return true
+		case "text/html":
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package shared
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWantsJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		accept   string
+		suffix   bool
+		expected bool
+	}{
+		{name: "Browser request", target: "/users/42", accept: "text/html,application/xhtml+xml,*/*;q=0.8", expected: false},
+		{name: "No Accept header", target: "/users/42", expected: false},
+		{name: "JSON Accept header", target: "/users/42", accept: "application/json", expected: true},
+		{name: "JSON before HTML", target: "/users/42", accept: "application/json, text/html", expected: true},
+		{name: "HTML before JSON", target: "/users/42", accept: "text/html, application/json", expected: false},
+		{name: "Format query", target: "/users/42?format=json", expected: true},
+		{name: "Format query overrides Accept", target: "/users/42?format=html", accept: "application/json", expected: false},
+		{name: "JSON suffix", target: "/users/42", suffix: true, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			if tt.suffix {
+				r = r.WithContext(context.WithValue(r.Context(), JSONSuffixKey, true))
+			}
+			assert.Equal(t, tt.expected, WantsJSON(r))
+		})
+	}
+}
+
+func TestTrimJSONSuffix(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+		ok       bool
+	}{
+		{path: "/users/42.json", expected: "/users/42", ok: true},
+		{path: "/en/dashboard.json", expected: "/en/dashboard", ok: true},
+		{path: "/users/42", expected: "/users/42", ok: false},
+		{path: "/.json", expected: "/.json", ok: false},
+		{path: "/users/.json", expected: "/users/.json", ok: false},
+	}
+
+	for _, tt := range tests {
+		trimmed, ok := TrimJSONSuffix(tt.path)
+		assert.Equal(t, tt.ok, ok, tt.path)
+		assert.Equal(t, tt.expected, trimmed, tt.path)
+	}
+}
@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"net/http"
+	"strings"
+)
+
+// CSRF token transport: the cookie holds the token, requests repeat it in the form field or header
+const (
+	CSRFCookieName = "csrf_token"
+	CSRFFieldName  = "csrf_token"
+	CSRFHeaderName = "X-CSRF-Token"
+)
+
+// IsSafeMethod checks if a request method must not change state (RFC 9110) and needs no CSRF token
+func IsSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// ParseSameSite converts a configured SameSite value ("strict", "lax", "none", any case) to
+// http.SameSite; unknown values fall back to Lax
+func ParseSameSite(value string) http.SameSite {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
+	}
+}
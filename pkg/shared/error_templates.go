@@ -0,0 +1,76 @@
+package shared
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ErrorTemplateName is the base name of the generic error template (error.templ)
+const ErrorTemplateName = "error"
+
+// statusErrorTemplateNames maps status codes to the readable error template names,
+// e.g. not-found.templ with the NotFound component handles 404 like error.404.templ
+var statusErrorTemplateNames = map[int]string{
+	401: "unauthorized",
+	403: "forbidden",
+	404: "not-found",
+	405: "method-not-allowed",
+}
+
+// ErrorTemplateNames returns the template base names handling a status code,
+// most specific first: error.404, not-found, error
+func ErrorTemplateNames(status int) []string {
+	var names []string
+	if status > 0 {
+		names = append(names, ErrorTemplateName+"."+strconv.Itoa(status))
+		if name, ok := statusErrorTemplateNames[status]; ok {
+			names = append(names, name)
+		}
+	}
+	return append(names, ErrorTemplateName)
+}
+
+// ErrorTemplateStatus returns the status code handled by an error template base name.
+// The generic error template handles every status (0); false for non-error templates.
+func ErrorTemplateStatus(templateName string) (int, bool) {
+	if templateName == ErrorTemplateName {
+		return 0, true
+	}
+
+	if code, found := strings.CutPrefix(templateName, ErrorTemplateName+"."); found {
+		status, err := strconv.Atoi(code)
+		if err != nil || len(code) != 3 || status < 400 || status > 599 {
+			return 0, false
+		}
+		return status, true
+	}
+
+	for status, name := range statusErrorTemplateNames {
+		if name == templateName {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
+// ErrorTemplateFunctionName returns the component an error template must define:
+// error -> Error, error.404 -> Error404, not-found -> NotFound
+func ErrorTemplateFunctionName(templateName string) (string, bool) {
+	status, ok := ErrorTemplateStatus(templateName)
+	if !ok {
+		return "", false
+	}
+
+	if status == 0 {
+		return "Error", true
+	}
+	if templateName != statusErrorTemplateNames[status] {
+		return "Error" + strconv.Itoa(status), true
+	}
+
+	var name strings.Builder
+	for _, part := range strings.Split(templateName, "-") {
+		name.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return name.String(), true
+}
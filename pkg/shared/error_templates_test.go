@@ -0,0 +1,41 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorTemplateNames(t *testing.T) {
+	assert.Equal(t, []string{"error.404", "not-found", "error"}, ErrorTemplateNames(404))
+	assert.Equal(t, []string{"error.500", "error"}, ErrorTemplateNames(500))
+	assert.Equal(t, []string{"error"}, ErrorTemplateNames(0))
+}
+
+func TestErrorTemplateFunctionName(t *testing.T) {
+	tests := []struct {
+		templateName string
+		expected     string
+		status       int
+		ok           bool
+	}{
+		{templateName: "error", expected: "Error", status: 0, ok: true},
+		{templateName: "error.404", expected: "Error404", status: 404, ok: true},
+		{templateName: "error.500", expected: "Error500", status: 500, ok: true},
+		{templateName: "not-found", expected: "NotFound", status: 404, ok: true},
+		{templateName: "method-not-allowed", expected: "MethodNotAllowed", status: 405, ok: true},
+		{templateName: "error.200", ok: false},
+		{templateName: "error.abc", ok: false},
+		{templateName: "page", ok: false},
+	}
+
+	for _, tt := range tests {
+		name, ok := ErrorTemplateFunctionName(tt.templateName)
+		assert.Equal(t, tt.ok, ok, tt.templateName)
+		assert.Equal(t, tt.expected, name, tt.templateName)
+
+		status, ok := ErrorTemplateStatus(tt.templateName)
+		assert.Equal(t, tt.ok, ok, tt.templateName)
+		assert.Equal(t, tt.status, status, tt.templateName)
+	}
+}
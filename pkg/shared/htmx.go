@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// HTMX request and response headers
+const (
+	HXRequestHeader    = "HX-Request"
+	HXBoostedHeader    = "HX-Boosted"
+	HXTargetHeader     = "HX-Target"
+	HXCurrentURLHeader = "HX-Current-URL"
+	HXRedirectHeader   = "HX-Redirect"
+)
+
+// FragmentQueryParam selects a fragment of a page, e.g. /en/dashboard?fragment=stats
+const FragmentQueryParam = "fragment"
+
+// IsHTMXRequest checks if the request was sent by HTMX
+func IsHTMXRequest(r *http.Request) bool {
+	return r.Header.Get(HXRequestHeader) == "true"
+}
+
+// IsHTMXPartialRequest checks if HTMX swaps the response into a part of the page.
+// Boosted requests replace the whole document and need the full page.
+func IsHTMXPartialRequest(r *http.Request) bool {
+	return IsHTMXRequest(r) && r.Header.Get(HXBoostedHeader) != "true"
+}
+
+// FragmentRouteSegment converts a fragment name to the route segment the template generator
+// uses for components of a page package, e.g. "Stats" -> "stats", "user-stats" -> "user_stats".
+// Returns false for names that are not a plain identifier.
+func FragmentRouteSegment(fragment string) (string, bool) {
+	if fragment == "" {
+		return "", false
+	}
+
+	var segment strings.Builder
+	for i, r := range fragment {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			if i > 0 {
+				segment.WriteRune('_')
+			}
+			segment.WriteRune(r - 'A' + 'a')
+		case r >= 'a' && r <= 'z', r == '_':
+			segment.WriteRune(r)
+		case r >= '0' && r <= '9' && i > 0:
+			segment.WriteRune(r)
+		case r == '-' && i > 0:
+			segment.WriteRune('_')
+		default:
+			return "", false
+		}
+	}
+	return segment.String(), true
+}
+
+// PackageComponentKey identifies a component by the directory of its template file and its
+// fragment segment, so every template file of a page package shares one key space,
+// e.g. ("app/dashboard/stats.templ", "UserStats") -> "app/dashboard#user_stats".
+// Returns "" for names that are not a plain identifier.
+func PackageComponentKey(templatePath, name string) string {
+	segment, ok := FragmentRouteSegment(name)
+	if !ok {
+		return ""
+	}
+	return path.Dir(filepath.ToSlash(templatePath)) + "#" + segment
+}
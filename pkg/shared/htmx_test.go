@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsHTMXPartialRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected bool
+	}{
+		{name: "Plain request", expected: false},
+		{name: "HTMX request", headers: map[string]string{HXRequestHeader: "true"}, expected: true},
+		{name: "Boosted request", headers: map[string]string{HXRequestHeader: "true", HXBoostedHeader: "true"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for key, value := range tt.headers {
+				r.Header.Set(key, value)
+			}
+			assert.Equal(t, tt.expected, IsHTMXPartialRequest(r))
+		})
+	}
+}
+
+func TestFragmentRouteSegment(t *testing.T) {
+	tests := []struct {
+		fragment string
+		expected string
+		ok       bool
+	}{
+		{fragment: "stats", expected: "stats", ok: true},
+		{fragment: "UserStats", expected: "user_stats", ok: true},
+		{fragment: "user-stats", expected: "user_stats", ok: true},
+		{fragment: "chart2", expected: "chart2", ok: true},
+		{fragment: "", ok: false},
+		{fragment: "../admin", ok: false},
+		{fragment: "admin/page", ok: false},
+		{fragment: "2chart", ok: false},
+	}
+
+	for _, tt := range tests {
+		segment, ok := FragmentRouteSegment(tt.fragment)
+		assert.Equal(t, tt.ok, ok, tt.fragment)
+		assert.Equal(t, tt.expected, segment, tt.fragment)
+	}
+}
+
+func TestPackageComponentKey(t *testing.T) {
+	assert.Equal(t, "app/dashboard#user_stats", PackageComponentKey("app/dashboard/stats.templ", "UserStats"))
+	assert.Equal(t, "app/dashboard#user_stats", PackageComponentKey("app/dashboard/page.templ", "user-stats"))
+	assert.Equal(t, "", PackageComponentKey("app/dashboard/page.templ", "../admin"))
+}
@@ -0,0 +1,17 @@
+package shared
+
+// RouteHandlerFileName is the plain Go file of an app directory that declares a route handler
+const RouteHandlerFileName = "route.go"
+
+// RouteHandlerName is the http.Handler (or handler function) a route.go file exports
+const RouteHandlerName = "Handler"
+
+// RouteHandlerKey identifies the route handler of an app directory,
+// e.g. "app/reports/export/route.go" -> "app/reports/export#handler"
+func RouteHandlerKey(filePath string) string {
+	return PackageComponentKey(filePath, RouteHandlerName)
+}
+
+// RouteHandlerMethods are the request methods served by a route handler. They are listed
+// explicitly so net/http ServeMux accepts them next to the GET patterns of sibling pages.
+var RouteHandlerMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
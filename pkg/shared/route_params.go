@@ -0,0 +1,216 @@
+package shared
+
+import "strings"
+
+// Catch-all route segment markers
+// A "slug___" directory maps to the pattern segment "{slug...}" and matches one or more path segments.
+// A "slug____" directory (one extra underscore) maps to "{slug...?}" and additionally
+// matches the section root. A leading underscore is not used because the go tool ignores
+// such directories.
+const (
+	CatchAllDirSuffix         = "___"
+	OptionalCatchAllDirSuffix = "____"
+	catchAllMarker            = "..."
+	optionalCatchAllMarker    = "...?"
+)
+
+// ParseRouteParamSegment returns the parameter name of a dynamic route segment.
+// Both the router syntax "$name" and the chi syntax "{name}" are recognized,
+// including catch-all segments such as "{slug...}" or "$slug...?".
+func ParseRouteParamSegment(segment string) (string, bool) {
+	if name, _, ok := ParseCatchAllSegment(segment); ok {
+		return name, true
+	}
+	if strings.HasPrefix(segment, "$") && len(segment) > 1 {
+		return segment[1:], true
+	}
+	if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") && len(segment) > 2 {
+		name := segment[1 : len(segment)-1]
+		// Strip an optional chi regexp constraint, e.g. {id:[0-9]+}
+		if idx := strings.Index(name, ":"); idx >= 0 {
+			name = name[:idx]
+		}
+		return name, name != ""
+	}
+	return "", false
+}
+
+// ParseCatchAllSegment returns the parameter name of a catch-all route segment
+// and whether it is optional, e.g. "{slug...}" -> (slug, false), "$slug...?" -> (slug, true)
+func ParseCatchAllSegment(segment string) (string, bool, bool) {
+	var inner string
+	switch {
+	case strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}"):
+		inner = segment[1 : len(segment)-1]
+	case strings.HasPrefix(segment, "$"):
+		inner = segment[1:]
+	default:
+		return "", false, false
+	}
+
+	if name := strings.TrimSuffix(inner, optionalCatchAllMarker); name != inner {
+		return name, true, name != ""
+	}
+	if name := strings.TrimSuffix(inner, catchAllMarker); name != inner {
+		return name, false, name != ""
+	}
+	return "", false, false
+}
+
+// SplitCatchAllPattern splits a pattern ending in a catch-all segment into its base path
+// e.g., "/docs/{slug...}" -> ("/docs", "slug", false, true)
+func SplitCatchAllPattern(pattern string) (string, string, bool, bool) {
+	idx := strings.LastIndex(pattern, "/")
+	if idx < 0 {
+		return "", "", false, false
+	}
+	name, optional, ok := ParseCatchAllSegment(pattern[idx+1:])
+	if !ok {
+		return "", "", false, false
+	}
+	base := pattern[:idx]
+	if base == "" {
+		base = "/"
+	}
+	return base, name, optional, true
+}
+
+// HasNonTrailingCatchAll reports whether a catch-all segment appears anywhere but at the end
+func HasNonTrailingCatchAll(pattern string) bool {
+	segments := strings.Split(pattern, "/")
+	for i, segment := range segments {
+		if _, _, ok := ParseCatchAllSegment(segment); ok && i != len(segments)-1 {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtractRouteParamNames returns the names of all dynamic segments in route order
+// e.g., "/{locale}/org/$orgId/project/{projectId}" -> [locale orgId projectId]
+func ExtractRouteParamNames(pattern string) []string {
+	var names []string
+	for _, segment := range strings.Split(pattern, "/") {
+		if name, ok := ParseRouteParamSegment(segment); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// RoutePatternsOverlap reports whether two patterns of equal depth can match the same request path,
+// i.e. every segment pair is either equal or contains a dynamic segment
+// e.g., "/user/{id}" and "/user/$name" overlap, "/user/{id}" and "/post/{id}" do not
+func RoutePatternsOverlap(pattern1, pattern2 string) bool {
+	parts1 := strings.Split(strings.Trim(pattern1, "/"), "/")
+	parts2 := strings.Split(strings.Trim(pattern2, "/"), "/")
+
+	if len(parts1) != len(parts2) {
+		return false
+	}
+
+	for i := 0; i < len(parts1); i++ {
+		p1, p2 := parts1[i], parts2[i]
+
+		// The root path has no segment a parameter could capture
+		if p1 == "" || p2 == "" {
+			if p1 != p2 {
+				return false
+			}
+			continue
+		}
+
+		// If both are static and different, no conflict
+		_, dynamic1 := ParseRouteParamSegment(p1)
+		_, dynamic2 := ParseRouteParamSegment(p2)
+		if !dynamic1 && !dynamic2 && p1 != p2 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsDynamicRoutePattern reports whether the pattern contains at least one dynamic segment
+func IsDynamicRoutePattern(pattern string) bool {
+	return len(ExtractRouteParamNames(pattern)) > 0
+}
+
+// ToChiRoutePattern converts every "$name" segment into chi "{name}" syntax
+// and a trailing catch-all segment into the chi wildcard "*"
+// e.g., "/$locale/org/$orgId" -> "/{locale}/org/{orgId}", "/docs/{slug...}" -> "/docs/*"
+func ToChiRoutePattern(pattern string) string {
+	segments := strings.Split(pattern, "/")
+	for i, segment := range segments {
+		if _, _, ok := ParseCatchAllSegment(segment); ok {
+			segments[i] = "*"
+			continue
+		}
+		if strings.HasPrefix(segment, "$") {
+			if name, ok := ParseRouteParamSegment(segment); ok {
+				segments[i] = "{" + name + "}"
+			}
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// DirectoryToRouteParam converts a "name_" directory into a parameter name
+// e.g., "orgId_" -> "orgId"
+func DirectoryToRouteParam(dir string) (string, bool) {
+	if _, _, ok := DirectoryToCatchAll(dir); ok {
+		return "", false
+	}
+	if len(dir) > 1 && strings.HasSuffix(dir, "_") {
+		return strings.TrimSuffix(dir, "_"), true
+	}
+	return "", false
+}
+
+// DirectoryToCatchAll converts a catch-all directory into its parameter name
+// e.g., "slug___" -> (slug, false), "slug____" -> (slug, true)
+func DirectoryToCatchAll(dir string) (string, bool, bool) {
+	if name := strings.TrimSuffix(dir, OptionalCatchAllDirSuffix); name != dir {
+		return name, true, name != "" && !strings.HasSuffix(name, "_")
+	}
+	if name := strings.TrimSuffix(dir, CatchAllDirSuffix); name != dir {
+		return name, false, name != "" && !strings.HasSuffix(name, "_")
+	}
+	return "", false, false
+}
+
+// CatchAllPatternSegment returns the route pattern segment for a catch-all parameter
+func CatchAllPatternSegment(name string, optional bool) string {
+	if optional {
+		return "{" + name + optionalCatchAllMarker + "}"
+	}
+	return "{" + name + catchAllMarker + "}"
+}
+
+// CatchAllDirectory returns the directory name for a catch-all parameter
+func CatchAllDirectory(name string, optional bool) string {
+	if optional {
+		return name + OptionalCatchAllDirSuffix
+	}
+	return name + CatchAllDirSuffix
+}
+
+// SplitPatternQuery splits a route pattern or target at its query separator, ignoring the "?"
+// of optional catch-all segments
+// e.g., "/docs/{slug...?}?ref=nav" -> "/docs/{slug...?}", "ref=nav", true
+func SplitPatternQuery(pattern string) (string, string, bool) {
+	depth := 0
+	for i, c := range pattern {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case '?':
+			if depth == 0 {
+				return pattern[:i], pattern[i+1:], true
+			}
+		}
+	}
+	return pattern, "", false
+}
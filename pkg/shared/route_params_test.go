@@ -0,0 +1,112 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRouteParamSegment(t *testing.T) {
+	tests := []struct {
+		segment  string
+		expected string
+		ok       bool
+	}{
+		{"$id", "id", true},
+		{"{orgId}", "orgId", true},
+		{"{id:[0-9]+}", "id", true},
+		{"{slug...}", "slug", true},
+		{"$slug...?", "slug", true},
+		{"$", "", false},
+		{"{}", "", false},
+		{"user", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.segment, func(t *testing.T) {
+			name, ok := ParseRouteParamSegment(tt.segment)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+}
+
+func TestExtractRouteParamNames(t *testing.T) {
+	assert.Equal(t, []string{"locale", "orgId", "projectId"},
+		ExtractRouteParamNames("/{locale}/org/$orgId/project/{projectId}"))
+	assert.Empty(t, ExtractRouteParamNames("/about"))
+}
+
+func TestToChiRoutePattern(t *testing.T) {
+	assert.Equal(t, "/{locale}/archive/{year}/{month}", ToChiRoutePattern("/$locale/archive/$year/$month"))
+	assert.Equal(t, "/{locale}/user/{id}", ToChiRoutePattern("/{locale}/user/{id}"))
+	assert.Equal(t, "/about", ToChiRoutePattern("/about"))
+	assert.Equal(t, "/{locale}/docs/*", ToChiRoutePattern("/$locale/docs/{slug...}"))
+}
+
+func TestDirectoryToRouteParam(t *testing.T) {
+	name, ok := DirectoryToRouteParam("orgId_")
+	assert.True(t, ok)
+	assert.Equal(t, "orgId", name)
+
+	_, ok = DirectoryToRouteParam("admin")
+	assert.False(t, ok)
+}
+
+func TestSplitCatchAllPattern(t *testing.T) {
+	base, name, optional, ok := SplitCatchAllPattern("/{locale}/docs/{slug...}")
+	assert.True(t, ok)
+	assert.Equal(t, "/{locale}/docs", base)
+	assert.Equal(t, "slug", name)
+	assert.False(t, optional)
+
+	base, name, optional, ok = SplitCatchAllPattern("/{path...?}")
+	assert.True(t, ok)
+	assert.Equal(t, "/", base)
+	assert.Equal(t, "path", name)
+	assert.True(t, optional)
+
+	_, _, _, ok = SplitCatchAllPattern("/docs/{slug}")
+	assert.False(t, ok)
+
+	assert.True(t, HasNonTrailingCatchAll("/docs/{slug...}/edit"))
+	assert.False(t, HasNonTrailingCatchAll("/docs/{slug...}"))
+}
+
+func TestDirectoryToCatchAll(t *testing.T) {
+	name, optional, ok := DirectoryToCatchAll("slug___")
+	assert.True(t, ok)
+	assert.Equal(t, "slug", name)
+	assert.False(t, optional)
+
+	name, optional, ok = DirectoryToCatchAll("slug____")
+	assert.True(t, ok)
+	assert.Equal(t, "slug", name)
+	assert.True(t, optional)
+
+	_, _, ok = DirectoryToCatchAll("slug_")
+	assert.False(t, ok)
+
+	_, ok = DirectoryToRouteParam("slug___")
+	assert.False(t, ok)
+}
+
+func TestRoutePatternsOverlap(t *testing.T) {
+	assert.True(t, RoutePatternsOverlap("/user/{id}", "/user/$name"))
+	assert.True(t, RoutePatternsOverlap("/{a}/edit", "/user/{b}"))
+	assert.True(t, RoutePatternsOverlap("/about", "/about/"))
+	assert.False(t, RoutePatternsOverlap("/user/{id}", "/post/{id}"))
+	assert.False(t, RoutePatternsOverlap("/user/{id}", "/user/{id}/edit"))
+	assert.False(t, RoutePatternsOverlap("/", "/{locale}"))
+}
+
+func TestSplitPatternQuery(t *testing.T) {
+	path, query, ok := SplitPatternQuery("/docs/{slug...?}?ref=nav")
+	assert.True(t, ok)
+	assert.Equal(t, "/docs/{slug...?}", path)
+	assert.Equal(t, "ref=nav", query)
+
+	path, _, ok = SplitPatternQuery("/docs/{slug...?}")
+	assert.False(t, ok)
+	assert.Equal(t, "/docs/{slug...?}", path)
+}
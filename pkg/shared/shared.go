@@ -25,4 +25,12 @@ const (
 	TemplatePathKey   ContextType = "template_path"
 	I18nDataKey       ContextType = "router_i18n_data"
 	I18nTemplateKey   ContextType = "router_i18n_template"
+	TypedURLParamsKey ContextType = "router_typed_url_params"
+	RewrittenFromKey  ContextType = "router_rewritten_from"
+	RouterAdapterKey  ContextType = "router_adapter"
+	RenderModeKey     ContextType = "router_render_mode"
+	ErrorInfoKey      ContextType = "router_error_info"
+	JSONSuffixKey     ContextType = "router_json_suffix"
+	ActionResultKey   ContextType = "router_action_result"
+	CSRFTokenKey      ContextType = "router_csrf_token"
 )
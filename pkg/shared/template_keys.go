@@ -65,7 +65,10 @@ func (tkg *TemplateKeyGenerator) ExtractRouteFromTemplatePath(templatePath strin
 		}
 		
 		// Convert special directory names to route parameters
-		if strings.HasSuffix(part, "_") {
+		if paramName, optional, ok := DirectoryToCatchAll(part); ok {
+			// slug___ -> {slug...}, slug____ -> {slug...?}
+			routeParts = append(routeParts, CatchAllPatternSegment(paramName, optional))
+		} else if strings.HasSuffix(part, "_") {
 			// locale_ -> {locale}
 			paramName := strings.TrimSuffix(part, "_")
 			routeParts = append(routeParts, "{"+paramName+"}")
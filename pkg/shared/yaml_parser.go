@@ -3,6 +3,8 @@ package shared
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
@@ -35,6 +37,15 @@ type ConfigFile struct {
 
 	// DynamicSettings contains dynamic parameter validation configuration
 	DynamicSettings interface{}
+
+	// APISettings contains the JSON API configuration of the page
+	APISettings interface{}
+
+	// RateLimitSettings contains the rate limit of the route
+	RateLimitSettings interface{}
+
+	// SecuritySettings contains the security headers of the route
+	SecuritySettings interface{}
 }
 
 // ParseYAMLMetadata parses YAML metadata files with validation
@@ -68,32 +79,42 @@ func ParseYAMLMetadata(filePath string) (bool, *ConfigFile, error) {
 
 	// Create a ConfigFile struct with the parsed data
 	configFile := &ConfigFile{
-		FilePath:        filePath,
-		RouteMetadata:   rawConfig["metadata"],
-		I18nMappings:    extractI18nMappings(rawConfig),
-		MultiLocaleI18n: extractMultiLocaleI18n(rawConfig),
-		AuthSettings:    rawConfig["auth"],
-		LayoutSettings:  rawConfig["layout"],
-		ErrorSettings:   rawConfig["error"],
-		DynamicSettings: rawConfig["dynamic"],
+		FilePath:          filePath,
+		RouteMetadata:     rawConfig["metadata"],
+		I18nMappings:      extractI18nMappings(rawConfig),
+		MultiLocaleI18n:   extractMultiLocaleI18n(rawConfig),
+		AuthSettings:      rawConfig["auth"],
+		LayoutSettings:    rawConfig["layout"],
+		ErrorSettings:     rawConfig["error"],
+		DynamicSettings:   rawConfig["dynamic"],
+		APISettings:       rawConfig["api"],
+		RateLimitSettings: rawConfig["rate_limit"],
+		SecuritySettings:  rawConfig["security"],
 	}
 
 	return true, configFile, nil
 }
 
+// allowedRootKeys are the root keys of a YAML config file, in the order error messages list them
+var allowedRootKeys = []string{
+	"i18n",
+	"auth",
+	"metadata",
+	"layout",
+	"error",
+	"dynamic",
+	"redirects",
+	"rewrites",
+	"api",
+	"rate_limit",
+	"security",
+}
+
 // validateRootKeys validates that only known root keys are used in YAML
 func validateRootKeys(rawConfig map[string]interface{}) error {
-	allowedKeys := map[string]bool{
-		"i18n":     true,
-		"auth":     true,
-		"metadata": true,
-		"error":    true,
-		"dynamic":  true,
-	}
-
 	for key := range rawConfig {
-		if !allowedKeys[key] {
-			return fmt.Errorf("unknown root key '%s' - allowed keys are: i18n, auth, metadata, layout, error, dynamic", key)
+		if !slices.Contains(allowedRootKeys, key) {
+			return fmt.Errorf("unknown root key '%s' - allowed keys are: %s", key, strings.Join(allowedRootKeys, ", "))
 		}
 	}
 
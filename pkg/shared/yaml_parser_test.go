@@ -131,6 +131,34 @@ func TestParseYAMLMetadata_FlatI18n(t *testing.T) {
 	assert.Empty(t, config.MultiLocaleI18n)
 }
 
+func TestParseYAMLMetadata_RedirectsWithI18n(t *testing.T) {
+	yamlContent := `redirects:
+  - from: /{locale}/users/{id}
+    to: /{locale}/user/{id}
+rewrites:
+  - from: /me/{id}
+    to: /en/user/{id}
+i18n:
+  en:
+    title: "Users"
+  de:
+    title: "Benutzer"`
+
+	tmpFile, err := os.CreateTemp("", "test_redirects_i18n_*.yaml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.WriteString(yamlContent)
+	require.NoError(t, err)
+	tmpFile.Close()
+
+	// Redirect sections must not invalidate the rest of the page metadata
+	_, config, err := ParseYAMLMetadata(tmpFile.Name())
+	require.NoError(t, err)
+	assert.Equal(t, "Users", config.MultiLocaleI18n["en"]["title"])
+	assert.Equal(t, "Benutzer", config.MultiLocaleI18n["de"]["title"])
+}
+
 func TestIsValidLocaleCode(t *testing.T) {
 	tests := []struct {
 		code     string
@@ -158,3 +186,13 @@ func TestIsValidLocaleCode(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRootKeysListsAllowedKeys(t *testing.T) {
+	err := validateRootKeys(map[string]interface{}{"ratelimit": nil})
+	require.Error(t, err)
+	for _, key := range allowedRootKeys {
+		assert.Contains(t, err.Error(), key)
+	}
+
+	assert.NoError(t, validateRootKeys(map[string]interface{}{"rate_limit": nil, "security": nil}))
+}